@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type Config struct {
@@ -31,13 +32,38 @@ type Config struct {
 type Client interface {
 	Ping(ctx context.Context) (*rpcpb.PingResponse, error)
 	Start(ctx context.Context, execPath string, opts ...OpOption) (*rpcpb.StartResponse, error)
-	Health(ctx context.Context) (*rpcpb.HealthResponse, error)
-	URIs(ctx context.Context) ([]string, error)
-	Status(ctx context.Context) (*rpcpb.StatusResponse, error)
-	StreamStatus(ctx context.Context, pushInterval time.Duration) (<-chan *rpcpb.ClusterInfo, error)
-	RemoveNode(ctx context.Context, name string) (*rpcpb.RemoveNodeResponse, error)
+	Health(ctx context.Context, opts ...OpOption) (*rpcpb.HealthResponse, error)
+	URIs(ctx context.Context, opts ...OpOption) ([]string, error)
+	Status(ctx context.Context, opts ...OpOption) (*rpcpb.StatusResponse, error)
+	StreamStatus(ctx context.Context, pushInterval time.Duration, opts ...OpOption) (<-chan *rpcpb.ClusterInfo, error)
+	StreamLogs(ctx context.Context, opts ...OpOption) (<-chan *rpcpb.StreamLogsResponse, error)
+	GetNodeMetrics(ctx context.Context, opts ...OpOption) (*rpcpb.GetNodeMetricsResponse, error)
+	WatchEvents(ctx context.Context, opts ...OpOption) (<-chan *rpcpb.Event, error)
+	WaitFor(ctx context.Context, conditions []*rpcpb.WaitCondition, opts ...OpOption) (*rpcpb.WaitForResponse, error)
+	AddNode(ctx context.Context, name string, execPath string, opts ...OpOption) (*rpcpb.AddNodeResponse, error)
+	RemoveNode(ctx context.Context, name string, opts ...OpOption) (*rpcpb.RemoveNodeResponse, error)
 	RestartNode(ctx context.Context, name string, execPath string, opts ...OpOption) (*rpcpb.RestartNodeResponse, error)
-	Stop(ctx context.Context) (*rpcpb.StopResponse, error)
+	RollingUpgrade(ctx context.Context, execPath string, opts ...OpOption) (*rpcpb.RollingUpgradeResponse, error)
+	PauseNode(ctx context.Context, name string, opts ...OpOption) (*rpcpb.PauseNodeResponse, error)
+	ResumeNode(ctx context.Context, name string, opts ...OpOption) (*rpcpb.ResumeNodeResponse, error)
+	Partition(ctx context.Context, groups [][]string, opts ...OpOption) (*rpcpb.PartitionResponse, error)
+	HealPartition(ctx context.Context, opts ...OpOption) (*rpcpb.HealPartitionResponse, error)
+	SetLinkConditions(ctx context.Context, conditions *rpcpb.SetLinkConditionsRequest, opts ...OpOption) (*rpcpb.SetLinkConditionsResponse, error)
+	Stop(ctx context.Context, opts ...OpOption) (*rpcpb.StopResponse, error)
+	ListClusters(ctx context.Context) (*rpcpb.ListClustersResponse, error)
+	GetOperation(ctx context.Context, id string) (*rpcpb.Operation, error)
+	ListOperations(ctx context.Context, opts ...OpOption) ([]*rpcpb.Operation, error)
+	WaitOperation(ctx context.Context, id string) (*rpcpb.Operation, error)
+	CancelOperation(ctx context.Context, id string) (*rpcpb.Operation, error)
+	SaveSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.SaveSnapshotResponse, error)
+	LoadSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.LoadSnapshotResponse, error)
+	ListSnapshots(ctx context.Context) ([]string, error)
+	RemoveSnapshot(ctx context.Context, snapshotName string) error
+	CreateSubnet(ctx context.Context, opts ...OpOption) (*rpcpb.CreateSubnetResponse, error)
+	AddSubnetValidators(ctx context.Context, subnetID string, opts ...OpOption) (*rpcpb.AddSubnetValidatorsResponse, error)
+	ListSubnets(ctx context.Context, opts ...OpOption) ([]*rpcpb.SubnetInfo, error)
+	CreateBlockchain(ctx context.Context, vmPath string, vmName string, genesis []byte, opts ...OpOption) (*rpcpb.CreateBlockchainResponse, error)
+	UpgradeVM(ctx context.Context, vmID string, vmPath string, opts ...OpOption) (*rpcpb.UpgradeVMResponse, error)
 	Close() error
 }
 
@@ -101,31 +127,54 @@ func (c *client) Start(ctx context.Context, execPath string, opts ...OpOption) (
 		ExecPath:           execPath,
 		WhitelistedSubnets: &ret.whitelistedSubnets,
 		LogLevel:           &ret.logLevel,
+		NumberOfNodes:      &ret.numNodes,
+		NodeSpecs:          ret.nodeSpecs,
+		GlobalNodeConfig:   &ret.globalNodeConfig,
+		Genesis:            ret.genesis,
+		GenesisParams:      ret.genesisParams,
+		ClusterName:        ret.clusterName,
+		WaitForHealthy:     ret.waitForHealthy,
+		RestartPolicy:      ret.restartPolicy,
+		PortRange:          ret.portRange,
+		ChainConfigs:       ret.chainConfigs,
 	})
 }
 
-func (c *client) Health(ctx context.Context) (*rpcpb.HealthResponse, error) {
+func (c *client) Health(ctx context.Context, opts ...OpOption) (*rpcpb.HealthResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
 	zap.L().Info("health")
-	return c.controlc.Health(ctx, &rpcpb.HealthRequest{})
+	return c.controlc.Health(ctx, &rpcpb.HealthRequest{ClusterName: ret.clusterName})
 }
 
-func (c *client) URIs(ctx context.Context) ([]string, error) {
+func (c *client) URIs(ctx context.Context, opts ...OpOption) ([]string, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
 	zap.L().Info("uris")
-	resp, err := c.controlc.URIs(ctx, &rpcpb.URIsRequest{})
+	resp, err := c.controlc.URIs(ctx, &rpcpb.URIsRequest{ClusterName: ret.clusterName})
 	if err != nil {
 		return nil, err
 	}
 	return resp.Uris, nil
 }
 
-func (c *client) Status(ctx context.Context) (*rpcpb.StatusResponse, error) {
+func (c *client) Status(ctx context.Context, opts ...OpOption) (*rpcpb.StatusResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
 	zap.L().Info("status")
-	return c.controlc.Status(ctx, &rpcpb.StatusRequest{})
+	return c.controlc.Status(ctx, &rpcpb.StatusRequest{ClusterName: ret.clusterName})
 }
 
-func (c *client) StreamStatus(ctx context.Context, pushInterval time.Duration) (<-chan *rpcpb.ClusterInfo, error) {
+func (c *client) StreamStatus(ctx context.Context, pushInterval time.Duration, opts ...OpOption) (<-chan *rpcpb.ClusterInfo, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
 	stream, err := c.controlc.StreamStatus(ctx, &rpcpb.StreamStatusRequest{
 		PushInterval: int64(pushInterval),
+		ClusterName:  ret.clusterName,
 	})
 	if err != nil {
 		return nil, err
@@ -170,14 +219,235 @@ func (c *client) StreamStatus(ctx context.Context, pushInterval time.Duration) (
 	return ch, nil
 }
 
-func (c *client) Stop(ctx context.Context) (*rpcpb.StopResponse, error) {
+func (c *client) Stop(ctx context.Context, opts ...OpOption) (*rpcpb.StopResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
 	zap.L().Info("stop")
-	return c.controlc.Stop(ctx, &rpcpb.StopRequest{})
+	return c.controlc.Stop(ctx, &rpcpb.StopRequest{ClusterName: ret.clusterName})
+}
+
+func (c *client) ListClusters(ctx context.Context) (*rpcpb.ListClustersResponse, error) {
+	zap.L().Info("list clusters")
+	return c.controlc.ListClusters(ctx, &rpcpb.ListClustersRequest{})
+}
+
+func (c *client) GetOperation(ctx context.Context, id string) (*rpcpb.Operation, error) {
+	zap.L().Info("get operation", zap.String("id", id))
+	resp, err := c.controlc.GetOperation(ctx, &rpcpb.GetOperationRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Operation, nil
+}
+
+// ListOperations lists the operations of the cluster set by WithClusterName,
+// or of all clusters if not set.
+func (c *client) ListOperations(ctx context.Context, opts ...OpOption) ([]*rpcpb.Operation, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("list operations")
+	resp, err := c.controlc.ListOperations(ctx, &rpcpb.ListOperationsRequest{ClusterName: ret.clusterName})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Operations, nil
+}
+
+// WaitOperation waits until the operation is done. A failed operation
+// is not an error; check the returned operation state.
+func (c *client) WaitOperation(ctx context.Context, id string) (*rpcpb.Operation, error) {
+	zap.L().Info("wait operation", zap.String("id", id))
+	resp, err := c.controlc.WaitOperation(ctx, &rpcpb.WaitOperationRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Operation, nil
+}
+
+func (c *client) CancelOperation(ctx context.Context, id string) (*rpcpb.Operation, error) {
+	zap.L().Info("cancel operation", zap.String("id", id))
+	resp, err := c.controlc.CancelOperation(ctx, &rpcpb.CancelOperationRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Operation, nil
+}
+
+func (c *client) SaveSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.SaveSnapshotResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("save snapshot", zap.String("snapshot", snapshotName))
+	return c.controlc.SaveSnapshot(ctx, &rpcpb.SaveSnapshotRequest{
+		ClusterName:  ret.clusterName,
+		SnapshotName: snapshotName,
+	})
+}
+
+// LoadSnapshot starts a new cluster from the snapshot. Use WithExecPath
+// to override the binaries of all nodes.
+func (c *client) LoadSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.LoadSnapshotResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("load snapshot", zap.String("snapshot", snapshotName))
+	return c.controlc.LoadSnapshot(ctx, &rpcpb.LoadSnapshotRequest{
+		SnapshotName: snapshotName,
+		ClusterName:  ret.clusterName,
+		ExecPath:     ret.execPath,
+		LogLevel:     ret.logLevel,
+	})
+}
+
+func (c *client) ListSnapshots(ctx context.Context) ([]string, error) {
+	zap.L().Info("list snapshots")
+	resp, err := c.controlc.ListSnapshots(ctx, &rpcpb.ListSnapshotsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.SnapshotNames, nil
+}
+
+func (c *client) RemoveSnapshot(ctx context.Context, snapshotName string) error {
+	zap.L().Info("remove snapshot", zap.String("snapshot", snapshotName))
+	_, err := c.controlc.RemoveSnapshot(ctx, &rpcpb.RemoveSnapshotRequest{SnapshotName: snapshotName})
+	return err
+}
+
+// GetNodeMetrics returns the metric families scraped from the nodes.
+func (c *client) GetNodeMetrics(ctx context.Context, opts ...OpOption) (*rpcpb.GetNodeMetricsResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("get node metrics")
+	return c.controlc.GetNodeMetrics(ctx, &rpcpb.GetNodeMetricsRequest{
+		ClusterName: ret.clusterName,
+		NodeNames:   ret.nodeNames,
+		MetricNames: ret.metricNames,
+	})
+}
+
+// StreamLogs streams the node output lines, until the server sends all
+// of them, or [ctx] is canceled when following.
+func (c *client) StreamLogs(ctx context.Context, opts ...OpOption) (<-chan *rpcpb.StreamLogsResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	stream, err := c.controlc.StreamLogs(ctx, &rpcpb.StreamLogsRequest{
+		ClusterName: ret.clusterName,
+		NodeNames:   ret.nodeNames,
+		Stream:      ret.logStream,
+		Tail:        ret.tail,
+		Follow:      ret.follow,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *rpcpb.StreamLogsResponse, 1)
+	go func() {
+		defer close(ch)
+		zap.L().Info("start receive routine")
+		for {
+			msg, err := stream.Recv()
+			if err == nil {
+				select {
+				case ch <- msg:
+				case <-ctx.Done():
+					return
+				case <-c.closed:
+					return
+				}
+				continue
+			}
+
+			if errors.Is(err, io.EOF) {
+				zap.L().Debug("received EOF from server")
+				return
+			}
+			if isClientCanceled(stream.Context().Err(), err) {
+				zap.L().Warn("failed to receive logs from gRPC stream due to client cancellation", zap.Error(err))
+			} else {
+				zap.L().Warn("failed to receive logs from gRPC stream", zap.Error(err))
+			}
+			return
+		}
+	}()
+	return ch, nil
+}
+
+// WatchEvents streams the cluster events, until [ctx] is canceled.
+func (c *client) WatchEvents(ctx context.Context, opts ...OpOption) (<-chan *rpcpb.Event, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	stream, err := c.controlc.WatchEvents(ctx, &rpcpb.WatchEventsRequest{
+		ClusterName:   ret.clusterName,
+		StartRevision: ret.startRevision,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *rpcpb.Event, 1)
+	go func() {
+		defer close(ch)
+		zap.L().Info("start receive routine")
+		for {
+			msg, err := stream.Recv()
+			if err == nil {
+				select {
+				case ch <- msg.GetEvent():
+				case <-ctx.Done():
+					return
+				case <-c.closed:
+					return
+				}
+				continue
+			}
+
+			if errors.Is(err, io.EOF) {
+				zap.L().Debug("received EOF from server")
+				return
+			}
+			if isClientCanceled(stream.Context().Err(), err) {
+				zap.L().Warn("failed to receive events from gRPC stream due to client cancellation", zap.Error(err))
+			} else {
+				zap.L().Warn("failed to receive events from gRPC stream", zap.Error(err))
+			}
+			return
+		}
+	}()
+	return ch, nil
+}
+
+func (c *client) AddNode(ctx context.Context, name string, execPath string, opts ...OpOption) (*rpcpb.AddNodeResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("add node", zap.String("name", name))
+	return c.controlc.AddNode(ctx, &rpcpb.AddNodeRequest{
+		NodeSpec: &rpcpb.NodeSpec{
+			Name:         name,
+			ExecPath:     execPath,
+			Config:       ret.nodeConfig,
+			StakingCert:  ret.stakingCert,
+			StakingKey:   ret.stakingKey,
+			ChainConfigs: ret.chainConfigs,
+		},
+		Validator:   ret.validator,
+		ClusterName: ret.clusterName,
+	})
 }
 
-func (c *client) RemoveNode(ctx context.Context, name string) (*rpcpb.RemoveNodeResponse, error) {
+func (c *client) RemoveNode(ctx context.Context, name string, opts ...OpOption) (*rpcpb.RemoveNodeResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
 	zap.L().Info("remove node", zap.String("name", name))
-	return c.controlc.RemoveNode(ctx, &rpcpb.RemoveNodeRequest{Name: name})
+	return c.controlc.RemoveNode(ctx, &rpcpb.RemoveNodeRequest{Name: name, ClusterName: ret.clusterName})
 }
 
 func (c *client) RestartNode(ctx context.Context, name string, execPath string, opts ...OpOption) (*rpcpb.RestartNodeResponse, error) {
@@ -191,9 +461,186 @@ func (c *client) RestartNode(ctx context.Context, name string, execPath string,
 			ExecPath:           execPath,
 			WhitelistedSubnets: &ret.whitelistedSubnets,
 		},
+		Config:       ret.nodeConfig,
+		ClusterName:  ret.clusterName,
+		ChainConfigs: ret.chainConfigs,
+	})
+}
+
+func (c *client) RollingUpgrade(ctx context.Context, execPath string, opts ...OpOption) (*rpcpb.RollingUpgradeResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("rolling upgrade", zap.String("exec-path", execPath))
+	return c.controlc.RollingUpgrade(ctx, &rpcpb.RollingUpgradeRequest{
+		ClusterName:    ret.clusterName,
+		ExecPath:       execPath,
+		NodeNames:      ret.upgradeOrder,
+		MaxUnavailable: ret.maxUnavailable,
+		HealthTimeout:  int64(ret.healthTimeout),
+	})
+}
+
+func (c *client) PauseNode(ctx context.Context, name string, opts ...OpOption) (*rpcpb.PauseNodeResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("pause node", zap.String("name", name))
+	return c.controlc.PauseNode(ctx, &rpcpb.PauseNodeRequest{Name: name, ClusterName: ret.clusterName})
+}
+
+func (c *client) ResumeNode(ctx context.Context, name string, opts ...OpOption) (*rpcpb.ResumeNodeResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("resume node", zap.String("name", name))
+	return c.controlc.ResumeNode(ctx, &rpcpb.ResumeNodeRequest{Name: name, ClusterName: ret.clusterName})
+}
+
+// Partition cuts the traffic between the node [groups]. Not supported
+// by the server with avalanchego v1.7.2.
+func (c *client) Partition(ctx context.Context, groups [][]string, opts ...OpOption) (*rpcpb.PartitionResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	req := &rpcpb.PartitionRequest{ClusterName: ret.clusterName}
+	for _, names := range groups {
+		req.Groups = append(req.Groups, &rpcpb.NodeGroup{NodeNames: names})
+	}
+	zap.L().Info("partition", zap.Int("groups", len(groups)))
+	return c.controlc.Partition(ctx, req)
+}
+
+func (c *client) HealPartition(ctx context.Context, opts ...OpOption) (*rpcpb.HealPartitionResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("heal partition")
+	return c.controlc.HealPartition(ctx, &rpcpb.HealPartitionRequest{ClusterName: ret.clusterName})
+}
+
+// SetLinkConditions shapes the traffic from one node to another. Not
+// supported by the server with avalanchego v1.7.2.
+func (c *client) SetLinkConditions(ctx context.Context, conditions *rpcpb.SetLinkConditionsRequest, opts ...OpOption) (*rpcpb.SetLinkConditionsResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	req := proto.Clone(conditions).(*rpcpb.SetLinkConditionsRequest)
+	req.ClusterName = ret.clusterName
+	zap.L().Info("set link conditions", zap.String("from", req.From), zap.String("to", req.To))
+	return c.controlc.SetLinkConditions(ctx, req)
+}
+
+// CreateSubnet creates a subnet validated by the nodes in the
+// WithNodeNames option (all nodes by default).
+func (c *client) CreateSubnet(ctx context.Context, opts ...OpOption) (*rpcpb.CreateSubnetResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("create subnet")
+	return c.controlc.CreateSubnet(ctx, &rpcpb.CreateSubnetRequest{
+		ClusterName: ret.clusterName,
+		NodeNames:   ret.nodeNames,
+	})
+}
+
+func (c *client) AddSubnetValidators(ctx context.Context, subnetID string, opts ...OpOption) (*rpcpb.AddSubnetValidatorsResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("add subnet validators", zap.String("subnet-id", subnetID))
+	return c.controlc.AddSubnetValidators(ctx, &rpcpb.AddSubnetValidatorsRequest{
+		ClusterName: ret.clusterName,
+		SubnetId:    subnetID,
+		NodeNames:   ret.nodeNames,
+	})
+}
+
+func (c *client) ListSubnets(ctx context.Context, opts ...OpOption) ([]*rpcpb.SubnetInfo, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("list subnets")
+	resp, err := c.controlc.ListSubnets(ctx, &rpcpb.ListSubnetsRequest{ClusterName: ret.clusterName})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Subnets, nil
+}
+
+// CreateBlockchain deploys the VM plugin at [vmPath], and creates a
+// blockchain of it in the WithSubnetID subnet (a new subnet by default).
+func (c *client) CreateBlockchain(ctx context.Context, vmPath string, vmName string, genesis []byte, opts ...OpOption) (*rpcpb.CreateBlockchainResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("create blockchain", zap.String("vm-path", vmPath), zap.String("vm-name", vmName))
+	return c.controlc.CreateBlockchain(ctx, &rpcpb.CreateBlockchainRequest{
+		ClusterName: ret.clusterName,
+		VmPath:      vmPath,
+		VmId:        ret.vmID,
+		VmName:      vmName,
+		Genesis:     genesis,
+		SubnetId:    ret.subnetID,
+		NodeNames:   ret.nodeNames,
+		ChainName:   ret.chainName,
+	})
+}
+
+// UpgradeVM replaces the plugin of [vmID] (VM ID or name) with [vmPath]
+// in the nodes that run its blockchains.
+func (c *client) UpgradeVM(ctx context.Context, vmID string, vmPath string, opts ...OpOption) (*rpcpb.UpgradeVMResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("upgrade VM", zap.String("vm-id", vmID), zap.String("vm-path", vmPath))
+	return c.controlc.UpgradeVM(ctx, &rpcpb.UpgradeVMRequest{
+		ClusterName:    ret.clusterName,
+		VmId:           vmID,
+		VmPath:         vmPath,
+		AllAtOnce:      ret.allAtOnce,
+		HealthTimeout:  int64(ret.healthTimeout),
+		BlockTimeout:   int64(ret.blockTimeout),
+		SkipBlockCheck: ret.skipBlockCheck,
 	})
 }
 
+// WaitFor blocks until all [conditions] hold, or the wait times out,
+// and returns the last condition statuses.
+func (c *client) WaitFor(ctx context.Context, conditions []*rpcpb.WaitCondition, opts ...OpOption) (*rpcpb.WaitForResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	zap.L().Info("wait for", zap.Int("conditions", len(conditions)))
+	stream, err := c.controlc.WaitFor(ctx, &rpcpb.WaitForRequest{
+		ClusterName:  ret.clusterName,
+		Conditions:   conditions,
+		Timeout:      int64(ret.waitTimeout),
+		PollInterval: int64(ret.pollInterval),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var last *rpcpb.WaitForResponse
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			if !last.GetSatisfied() {
+				return last, errors.New("wait ended before the conditions were satisfied")
+			}
+			return last, nil
+		}
+		if err != nil {
+			return last, err
+		}
+		last = msg
+		if ret.onProgress != nil {
+			ret.onProgress(msg)
+		}
+	}
+}
+
 func (c *client) Close() error {
 	c.closeOnce.Do(func() {
 		close(c.closed)
@@ -204,6 +651,39 @@ func (c *client) Close() error {
 type Op struct {
 	whitelistedSubnets string
 	logLevel           string
+	numNodes           uint32
+	nodeSpecs          []*rpcpb.NodeSpec
+	globalNodeConfig   string
+	genesis            []byte
+	genesisParams      *rpcpb.GenesisParams
+	nodeConfig         string
+	validator          bool
+	clusterName        string
+	execPath           string
+	waitForHealthy     bool
+	upgradeOrder       []string
+	maxUnavailable     uint32
+	healthTimeout      time.Duration
+	nodeNames          []string
+	logStream          string
+	tail               uint32
+	follow             bool
+	startRevision      uint64
+	restartPolicy      *rpcpb.RestartPolicy
+	metricNames        []string
+	portRange          *rpcpb.PortRange
+	stakingCert        string
+	stakingKey         string
+	vmID               string
+	subnetID           string
+	chainName          string
+	allAtOnce          bool
+	blockTimeout       time.Duration
+	skipBlockCheck     bool
+	chainConfigs       map[string]*rpcpb.ChainConfig
+	waitTimeout        time.Duration
+	pollInterval       time.Duration
+	onProgress         func(*rpcpb.WaitForResponse)
 }
 
 type OpOption func(*Op)
@@ -226,6 +706,254 @@ func WithLogLevel(logLevel string) OpOption {
 	}
 }
 
+// WithNumberOfNodes sets the number of nodes to start.
+// Zero defaults to the number of node specs (or five if none given).
+func WithNumberOfNodes(numNodes uint32) OpOption {
+	return func(op *Op) {
+		op.numNodes = numNodes
+	}
+}
+
+// WithNodeSpecs sets the names, binaries and extra configs of the
+// first len(nodeSpecs) nodes.
+func WithNodeSpecs(nodeSpecs ...*rpcpb.NodeSpec) OpOption {
+	return func(op *Op) {
+		op.nodeSpecs = nodeSpecs
+	}
+}
+
+// WithGlobalNodeConfig sets the avalanchego config (JSON object) merged
+// over the defaults for all nodes.
+func WithGlobalNodeConfig(globalNodeConfig string) OpOption {
+	return func(op *Op) {
+		op.globalNodeConfig = globalNodeConfig
+	}
+}
+
+// WithGenesis sets the custom genesis (JSON) of the cluster.
+func WithGenesis(genesis []byte) OpOption {
+	return func(op *Op) {
+		op.genesis = genesis
+	}
+}
+
+// WithGenesisParams sets the parameters to generate the genesis from.
+// Ignored if a custom genesis is given.
+func WithGenesisParams(genesisParams *rpcpb.GenesisParams) OpOption {
+	return func(op *Op) {
+		op.genesisParams = genesisParams
+	}
+}
+
+// WithNodeConfig sets the avalanchego config (JSON object) of the node
+// to add or restart, merged over its defaults or current config.
+func WithNodeConfig(nodeConfig string) OpOption {
+	return func(op *Op) {
+		op.nodeConfig = nodeConfig
+	}
+}
+
+// WithStakingKey sets the PEM encoded staking TLS cert and key
+// of the node to add.
+func WithStakingKey(cert []byte, key []byte) OpOption {
+	return func(op *Op) {
+		op.stakingCert = string(cert)
+		op.stakingKey = string(key)
+	}
+}
+
+// WithValidator makes the node to add a primary network validator.
+func WithValidator(validator bool) OpOption {
+	return func(op *Op) {
+		op.validator = validator
+	}
+}
+
+// WithClusterName sets the name of the cluster to operate on.
+// Empty defaults to the "default" cluster.
+func WithClusterName(clusterName string) OpOption {
+	return func(op *Op) {
+		op.clusterName = clusterName
+	}
+}
+
+// WithExecPath sets the avalanchego binary path.
+func WithExecPath(execPath string) OpOption {
+	return func(op *Op) {
+		op.execPath = execPath
+	}
+}
+
+// WithWaitForHealthy makes Start block until all nodes are healthy,
+// or the context deadline. On failure, the server rolls back the cluster.
+func WithWaitForHealthy(waitForHealthy bool) OpOption {
+	return func(op *Op) {
+		op.waitForHealthy = waitForHealthy
+	}
+}
+
+// WithRestartPolicy sets how the nodes are restarted when their
+// processes exit. Node specs may override it.
+func WithRestartPolicy(restartPolicy *rpcpb.RestartPolicy) OpOption {
+	return func(op *Op) {
+		op.restartPolicy = restartPolicy
+	}
+}
+
+// WithPortRange sets the ports to allocate the node API and staking
+// ports from, up to 65535 if [end] is zero. Any free ports are used
+// by default.
+func WithPortRange(start uint32, end uint32) OpOption {
+	return func(op *Op) {
+		op.portRange = &rpcpb.PortRange{Start: start, End: end}
+	}
+}
+
+// WithUpgradeOrder sets the nodes to upgrade, in order.
+// All nodes are upgraded in cluster order by default.
+func WithUpgradeOrder(nodeNames ...string) OpOption {
+	return func(op *Op) {
+		op.upgradeOrder = nodeNames
+	}
+}
+
+// WithMaxUnavailable sets the number of nodes restarted at a time.
+func WithMaxUnavailable(maxUnavailable uint32) OpOption {
+	return func(op *Op) {
+		op.maxUnavailable = maxUnavailable
+	}
+}
+
+// WithHealthTimeout sets how long to wait for the network to be
+// healthy after each upgrade step.
+func WithHealthTimeout(healthTimeout time.Duration) OpOption {
+	return func(op *Op) {
+		op.healthTimeout = healthTimeout
+	}
+}
+
+// WithNodeNames sets the nodes to stream logs or metrics from, or to
+// validate the subnet (all nodes by default).
+func WithNodeNames(nodeNames ...string) OpOption {
+	return func(op *Op) {
+		op.nodeNames = nodeNames
+	}
+}
+
+// WithMetricNames sets the metric families to return (all by default).
+func WithMetricNames(metricNames ...string) OpOption {
+	return func(op *Op) {
+		op.metricNames = metricNames
+	}
+}
+
+// WithChainConfigs sets the chain configs, keyed by chain alias or
+// blockchain ID, of the started, added or restarted nodes.
+func WithChainConfigs(chainConfigs map[string]*rpcpb.ChainConfig) OpOption {
+	return func(op *Op) {
+		op.chainConfigs = chainConfigs
+	}
+}
+
+// WithVMID sets the VM ID of the blockchain (derived from the VM name
+// by default).
+func WithVMID(vmID string) OpOption {
+	return func(op *Op) {
+		op.vmID = vmID
+	}
+}
+
+// WithSubnetID sets the subnet to create the blockchain in.
+func WithSubnetID(subnetID string) OpOption {
+	return func(op *Op) {
+		op.subnetID = subnetID
+	}
+}
+
+// WithChainName sets the blockchain name (the VM name by default).
+func WithChainName(chainName string) OpOption {
+	return func(op *Op) {
+		op.chainName = chainName
+	}
+}
+
+// WithAllAtOnce restarts all nodes at once on "UpgradeVM", instead of
+// one at a time.
+func WithAllAtOnce(allAtOnce bool) OpOption {
+	return func(op *Op) {
+		op.allAtOnce = allAtOnce
+	}
+}
+
+// WithBlockTimeout sets the time to wait for the upgraded chains to
+// accept a new block (1 minute by default).
+func WithBlockTimeout(blockTimeout time.Duration) OpOption {
+	return func(op *Op) {
+		op.blockTimeout = blockTimeout
+	}
+}
+
+// WithSkipBlockCheck skips waiting for the upgraded chains to accept
+// a new block on "UpgradeVM".
+func WithSkipBlockCheck(skipBlockCheck bool) OpOption {
+	return func(op *Op) {
+		op.skipBlockCheck = skipBlockCheck
+	}
+}
+
+// WithLogStream sets the node output to stream: "stdout" (default),
+// "stderr", or a log file name under the node log directory.
+func WithLogStream(logStream string) OpOption {
+	return func(op *Op) {
+		op.logStream = logStream
+	}
+}
+
+// WithTail sets the number of last lines to stream first.
+func WithTail(tail uint32) OpOption {
+	return func(op *Op) {
+		op.tail = tail
+	}
+}
+
+// WithFollow keeps streaming new lines until the context is canceled.
+func WithFollow(follow bool) OpOption {
+	return func(op *Op) {
+		op.follow = follow
+	}
+}
+
+// WithStartRevision sets the revision to watch events from,
+// to resume from the last seen revision + 1.
+func WithStartRevision(startRevision uint64) OpOption {
+	return func(op *Op) {
+		op.startRevision = startRevision
+	}
+}
+
+// WithWaitTimeout sets the time to wait for the "WaitFor" conditions
+// (5 minutes, by default).
+func WithWaitTimeout(waitTimeout time.Duration) OpOption {
+	return func(op *Op) {
+		op.waitTimeout = waitTimeout
+	}
+}
+
+// WithPollInterval sets the interval between the "WaitFor" checks.
+func WithPollInterval(pollInterval time.Duration) OpOption {
+	return func(op *Op) {
+		op.pollInterval = pollInterval
+	}
+}
+
+// WithProgress calls [onProgress] with the condition statuses,
+// whenever they change while waiting.
+func WithProgress(onProgress func(*rpcpb.WaitForResponse)) OpOption {
+	return func(op *Op) {
+		op.onProgress = onProgress
+	}
+}
+
 func isClientCanceled(ctxErr error, err error) bool {
 	if ctxErr != nil {
 		return true
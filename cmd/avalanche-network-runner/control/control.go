@@ -5,16 +5,28 @@ package control
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/gyuho/avax-tester/client"
 	"github.com/gyuho/avax-tester/pkg/color"
 	"github.com/gyuho/avax-tester/pkg/logutil"
+	"github.com/gyuho/avax-tester/rpcpb"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func init() {
@@ -26,6 +38,7 @@ var (
 	endpoint       string
 	dialTimeout    time.Duration
 	requestTimeout time.Duration
+	clusterName    string
 )
 
 func NewCommand() *cobra.Command {
@@ -38,6 +51,7 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&endpoint, "endpoint", "0.0.0.0:8080", "server endpoint")
 	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", 10*time.Second, "server dial timeout")
 	cmd.PersistentFlags().DurationVar(&requestTimeout, "request-timeout", time.Minute, "client request timeout")
+	cmd.PersistentFlags().StringVar(&clusterName, "cluster", "", "cluster name (defaults to \"default\")")
 
 	cmd.AddCommand(
 		newStartCommand(),
@@ -45,9 +59,35 @@ func NewCommand() *cobra.Command {
 		newURIsCommand(),
 		newStatusCommand(),
 		newStreamStatusCommand(),
+		newLogsCommand(),
+		newNodeMetricsCommand(),
+		newWatchEventsCommand(),
+		newWaitCommand(),
+		newAddNodeCommand(),
 		newRemoveNodeCommand(),
 		newRestartNodeCommand(),
+		newRollingUpgradeCommand(),
+		newPauseNodeCommand(),
+		newResumeNodeCommand(),
+		newPartitionCommand(),
+		newHealPartitionCommand(),
+		newSetLinkConditionsCommand(),
+		newCreateSubnetCommand(),
+		newAddSubnetValidatorsCommand(),
+		newListSubnetsCommand(),
+		newCreateBlockchainCommand(),
+		newUpgradeVMCommand(),
 		newStopCommand(),
+		newListClustersCommand(),
+		newSaveSnapshotCommand(),
+		newLoadSnapshotCommand(),
+		newListSnapshotsCommand(),
+		newRemoveSnapshotCommand(),
+		newGetOperationCommand(),
+		newListOperationsCommand(),
+		newWaitOperationCommand(),
+		newCancelOperationCommand(),
+		newKeysCommand(),
 	)
 
 	return cmd
@@ -56,6 +96,19 @@ func NewCommand() *cobra.Command {
 var (
 	avalancheGoBinPath string
 	whitelistedSubnets string
+	numNodes           uint32
+	nodeSpecs          string
+	globalNodeConfig   string
+	genesisPath        string
+	genesisParams      string
+	waitForHealthy     bool
+	restartPolicy      string
+	maxRestarts        uint32
+	restartBackoff     time.Duration
+	maxRestartBackoff  time.Duration
+	portRange          string
+	stakingKeysDir     string
+	chainConfigsDir    string
 )
 
 func newStartCommand() *cobra.Command {
@@ -76,6 +129,84 @@ func newStartCommand() *cobra.Command {
 		"",
 		"whitelisted subnets (comma-separated)",
 	)
+	cmd.PersistentFlags().Uint32Var(
+		&numNodes,
+		"number-of-nodes",
+		0,
+		"number of nodes to start (defaults to the number of node specs, or 5)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&nodeSpecs,
+		"node-specs",
+		"",
+		"JSON array of per-node specs (e.g., '[{\"name\":\"node1\",\"execPath\":\"...\",\"config\":\"{}\"}]')",
+	)
+	cmd.PersistentFlags().StringVar(
+		&globalNodeConfig,
+		"global-node-config",
+		"",
+		"avalanchego config (JSON object) to merge over the defaults for all nodes",
+	)
+	cmd.PersistentFlags().StringVar(
+		&genesisPath,
+		"genesis-path",
+		"",
+		"custom genesis file path (every initial staker must be a node in the cluster)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&genesisParams,
+		"genesis-params",
+		"",
+		"JSON parameters to generate the genesis from (e.g., '{\"allocations\":[{\"avaxAddr\":\"X-custom1...\",\"initialAmount\":\"1000000\"}]}')",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&waitForHealthy,
+		"wait-for-healthy",
+		false,
+		"true to wait until all nodes are healthy, or the request timeout (rolls back the cluster on failure)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&restartPolicy,
+		"restart-policy",
+		"",
+		"restart policy of the exited nodes: 'never' (default), 'on-failure', or 'always'",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&maxRestarts,
+		"max-restarts",
+		0,
+		"number of restarts before giving up, until the node is healthy again (0 for no limit)",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&restartBackoff,
+		"restart-backoff",
+		0,
+		"initial delay before restarting an exited node, doubled on each retry (defaults to 1s)",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&maxRestartBackoff,
+		"max-restart-backoff",
+		0,
+		"max delay before restarting an exited node (defaults to 1m)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&portRange,
+		"port-range",
+		"",
+		"ports to allocate the node API and staking ports from, as 'START-END' or a base port 'START' (any free ports if empty)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&stakingKeysDir,
+		"staking-keys-dir",
+		"",
+		"directory with a '<node name>/staking.{crt,key}' pair per node (e.g., from 'keys generate'), added to the node specs",
+	)
+	cmd.PersistentFlags().StringVar(
+		&chainConfigsDir,
+		"chain-configs-dir",
+		"",
+		"directory with the '<chain alias or ID>/{config,upgrade}.json' chain configs of all nodes",
+	)
 	return cmd
 }
 
@@ -90,8 +221,62 @@ func startFunc(cmd *cobra.Command, args []string) error {
 	}
 	defer cli.Close()
 
+	specs, err := parseNodeSpecs(nodeSpecs)
+	if err != nil {
+		return err
+	}
+	if stakingKeysDir != "" {
+		specs, err = withStakingKeys(specs, stakingKeysDir)
+		if err != nil {
+			return err
+		}
+	}
+	opts := []client.OpOption{
+		client.WithWhitelistedSubnets(whitelistedSubnets),
+		client.WithNumberOfNodes(numNodes),
+		client.WithNodeSpecs(specs...),
+		client.WithGlobalNodeConfig(globalNodeConfig),
+		client.WithClusterName(clusterName),
+		client.WithWaitForHealthy(waitForHealthy),
+	}
+	if genesisPath != "" {
+		genesis, err := os.ReadFile(genesisPath)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithGenesis(genesis))
+	}
+	if genesisParams != "" {
+		params := &rpcpb.GenesisParams{}
+		if err := protojson.Unmarshal([]byte(genesisParams), params); err != nil {
+			return err
+		}
+		opts = append(opts, client.WithGenesisParams(params))
+	}
+	if restartPolicy != "" {
+		policy, err := parseRestartPolicy(restartPolicy)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithRestartPolicy(policy))
+	}
+	if portRange != "" {
+		start, end, err := parsePortRange(portRange)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithPortRange(start, end))
+	}
+	if chainConfigsDir != "" {
+		configs, err := readChainConfigs(chainConfigsDir)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithChainConfigs(configs))
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	info, err := cli.Start(ctx, avalancheGoBinPath, client.WithWhitelistedSubnets(whitelistedSubnets))
+	info, err := cli.Start(ctx, avalancheGoBinPath, opts...)
 	cancel()
 	if err != nil {
 		return err
@@ -101,6 +286,135 @@ func startFunc(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// parseNodeSpecs parses a JSON array of node specs, accepting both the
+// gRPC gateway (camelCase) and the proto (snake_case) field names.
+func parseNodeSpecs(s string) ([]*rpcpb.NodeSpec, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var raws []json.RawMessage
+	if err := json.Unmarshal([]byte(s), &raws); err != nil {
+		return nil, err
+	}
+	specs := make([]*rpcpb.NodeSpec, len(raws))
+	for i, raw := range raws {
+		specs[i] = &rpcpb.NodeSpec{}
+		if err := protojson.Unmarshal(raw, specs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return specs, nil
+}
+
+func parseRestartPolicy(s string) (*rpcpb.RestartPolicy, error) {
+	policy := &rpcpb.RestartPolicy{
+		MaxRetries:     maxRestarts,
+		InitialBackoff: int64(restartBackoff),
+		MaxBackoff:     int64(maxRestartBackoff),
+	}
+	switch s {
+	case "never":
+		policy.Type = rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_NEVER
+	case "on-failure":
+		policy.Type = rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ON_FAILURE
+	case "always":
+		policy.Type = rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ALWAYS
+	default:
+		return nil, fmt.Errorf("unknown restart policy %q", s)
+	}
+	return policy, nil
+}
+
+// withStakingKeys sets the staking keys in [dir] in the node specs of the
+// same names, and appends the specs of the other nodes with keys.
+func withStakingKeys(specs []*rpcpb.NodeSpec, dir string) ([]*rpcpb.NodeSpec, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*rpcpb.NodeSpec, len(specs))
+	for _, spec := range specs {
+		if spec.Name != "" {
+			byName[spec.Name] = spec
+		}
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		cert, key, err := readStakingKey(dir, e.Name())
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		spec, ok := byName[e.Name()]
+		if !ok {
+			spec = &rpcpb.NodeSpec{Name: e.Name()}
+			specs = append(specs, spec)
+		}
+		spec.StakingCert, spec.StakingKey = string(cert), string(key)
+	}
+	return specs, nil
+}
+
+func readStakingKey(dir string, name string) ([]byte, []byte, error) {
+	cert, err := os.ReadFile(filepath.Join(dir, name, stakingCertFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := os.ReadFile(filepath.Join(dir, name, stakingKeyFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// readChainConfigs reads the chain configs laid out as in "--chain-config-dir".
+func readChainConfigs(dir string) (map[string]*rpcpb.ChainConfig, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	configs := make(map[string]*rpcpb.ChainConfig)
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		cc := &rpcpb.ChainConfig{}
+		for file, v := range map[string]*string{"config.json": &cc.Config, "upgrade.json": &cc.Upgrade} {
+			b, err := os.ReadFile(filepath.Join(dir, e.Name(), file))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+				return nil, err
+			}
+			*v = string(b)
+		}
+		configs[e.Name()] = cc
+	}
+	return configs, nil
+}
+
+// parsePortRange parses "START-END", or "START" for the ports from START.
+func parsePortRange(s string) (uint32, uint32, error) {
+	ss := strings.SplitN(s, "-", 2)
+	start, err := strconv.ParseUint(ss[0], 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port range %q: %w", s, err)
+	}
+	end := uint64(0)
+	if len(ss) == 2 {
+		end, err = strconv.ParseUint(ss[1], 10, 16)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid port range %q: %w", s, err)
+		}
+	}
+	return uint32(start), uint32(end), nil
+}
+
 func newHealthCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "health [options]",
@@ -122,7 +436,7 @@ func healthFunc(cmd *cobra.Command, args []string) error {
 	defer cli.Close()
 
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	resp, err := cli.Health(ctx)
+	resp, err := cli.Health(ctx, client.WithClusterName(clusterName))
 	cancel()
 	if err != nil {
 		return err
@@ -153,7 +467,7 @@ func urisFunc(cmd *cobra.Command, args []string) error {
 	defer cli.Close()
 
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	uris, err := cli.URIs(ctx)
+	uris, err := cli.URIs(ctx, client.WithClusterName(clusterName))
 	cancel()
 	if err != nil {
 		return err
@@ -184,7 +498,7 @@ func statusFunc(cmd *cobra.Command, args []string) error {
 	defer cli.Close()
 
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	resp, err := cli.Status(ctx)
+	resp, err := cli.Status(ctx, client.WithClusterName(clusterName))
 	cancel()
 	if err != nil {
 		return err
@@ -238,7 +552,7 @@ func streamStatusFunc(cmd *cobra.Command, args []string) error {
 		close(donec)
 	}()
 
-	ch, err := cli.StreamStatus(ctx, pushInterval)
+	ch, err := cli.StreamStatus(ctx, pushInterval, client.WithClusterName(clusterName))
 	if err != nil {
 		return err
 	}
@@ -250,19 +564,42 @@ func streamStatusFunc(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-var nodeName string
+var (
+	nodeName   string
+	nodeConfig string
+	validator  bool
+)
 
-func newRemoveNodeCommand() *cobra.Command {
+var (
+	logStream string
+	logTail   uint32
+	logFollow bool
+)
+
+var metricNames string
+
+func newNodeMetricsCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove-node [options]",
-		Short: "Removes a node.",
-		RunE:  removeNodeFunc,
+		Use:   "node-metrics [options]",
+		Short: "Requests the metrics of the nodes.",
+		RunE:  nodeMetricsFunc,
 	}
-	cmd.PersistentFlags().StringVar(&nodeName, "node-name", "", "node name to remove")
+	cmd.PersistentFlags().StringVar(
+		&nodeName,
+		"node-name",
+		"",
+		"node names to scrape (comma-separated, all nodes if empty)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&metricNames,
+		"metric-names",
+		"",
+		"metric family names to return (comma-separated, all if empty)",
+	)
 	return cmd
 }
 
-func removeNodeFunc(cmd *cobra.Command, args []string) error {
+func nodeMetricsFunc(cmd *cobra.Command, args []string) error {
 	cli, err := client.New(client.Config{
 		LogLevel:    logLevel,
 		Endpoint:    endpoint,
@@ -273,45 +610,76 @@ func removeNodeFunc(cmd *cobra.Command, args []string) error {
 	}
 	defer cli.Close()
 
+	opts := []client.OpOption{client.WithClusterName(clusterName)}
+	if nodeName != "" {
+		opts = append(opts, client.WithNodeNames(strings.Split(nodeName, ",")...))
+	}
+	if metricNames != "" {
+		opts = append(opts, client.WithMetricNames(strings.Split(metricNames, ",")...))
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	info, err := cli.RemoveNode(ctx, nodeName)
+	resp, err := cli.GetNodeMetrics(ctx, opts...)
 	cancel()
 	if err != nil {
 		return err
 	}
 
-	color.Outf("{{green}}remove node response:{{/}} %+v\n", info)
+	names := make([]string, 0, len(resp.NodeMetrics))
+	for name := range resp.NodeMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		nm := resp.NodeMetrics[name]
+		if nm.Error != "" {
+			color.Outf("{{red}}%s (%s):{{/}} %s\n", name, nm.NodeId, nm.Error)
+			continue
+		}
+		color.Outf("{{green}}%s (%s):{{/}}\n", name, nm.NodeId)
+		for _, mf := range nm.MetricFamilies {
+			for _, sample := range mf.Samples {
+				fmt.Printf("%s%v %v\n", sample.Name, sample.Labels, sample.Value)
+			}
+		}
+	}
 	return nil
 }
 
-func newRestartNodeCommand() *cobra.Command {
+func newLogsCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "restart-node [options]",
-		Short: "Restarts the server.",
-		RunE:  restartNodeFunc,
+		Use:   "logs [options]",
+		Short: "Streams the node logs.",
+		RunE:  logsFunc,
 	}
 	cmd.PersistentFlags().StringVar(
 		&nodeName,
 		"node-name",
 		"",
-		"node name to restart",
+		"node names to stream logs from (comma-separated, all nodes if empty)",
 	)
 	cmd.PersistentFlags().StringVar(
-		&avalancheGoBinPath,
-		"avalanchego-path",
-		"",
-		"avalanchego binary path",
+		&logStream,
+		"stream",
+		"stdout",
+		"\"stdout\", \"stderr\", or a log file name under the node log directory (e.g., \"main.log\")",
 	)
-	cmd.PersistentFlags().StringVar(
-		&whitelistedSubnets,
-		"whitelisted-subnets",
-		"",
-		"whitelisted subnets (comma-separated)",
+	cmd.PersistentFlags().Uint32Var(
+		&logTail,
+		"tail",
+		0,
+		"number of last lines to show first (all, if zero)",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&logFollow,
+		"follow",
+		false,
+		"true to keep streaming new lines until interrupted",
 	)
 	return cmd
 }
 
-func restartNodeFunc(cmd *cobra.Command, args []string) error {
+func logsFunc(cmd *cobra.Command, args []string) error {
 	cli, err := client.New(client.Config{
 		LogLevel:    logLevel,
 		Endpoint:    endpoint,
@@ -322,27 +690,208 @@ func restartNodeFunc(cmd *cobra.Command, args []string) error {
 	}
 	defer cli.Close()
 
-	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	info, err := cli.RestartNode(ctx, nodeName, avalancheGoBinPath, client.WithWhitelistedSubnets(whitelistedSubnets))
-	cancel()
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if logFollow {
+		// runs until interrupted
+		ctx, cancel = context.WithCancel(context.Background())
+	} else {
+		ctx, cancel = context.WithTimeout(context.Background(), requestTimeout)
+	}
+	donec := make(chan struct{})
+	go func() {
+		select {
+		case sig := <-sigc:
+			zap.L().Warn("received signal", zap.String("signal", sig.String()))
+		case <-ctx.Done():
+		}
+		cancel()
+		close(donec)
+	}()
+
+	opts := []client.OpOption{
+		client.WithLogStream(logStream),
+		client.WithTail(logTail),
+		client.WithFollow(logFollow),
+		client.WithClusterName(clusterName),
+	}
+	if nodeName != "" {
+		opts = append(opts, client.WithNodeNames(strings.Split(nodeName, ",")...))
+	}
+	ch, err := cli.StreamLogs(ctx, opts...)
 	if err != nil {
+		cancel()
+		<-donec
 		return err
 	}
+	for resp := range ch {
+		color.Outf("{{cyan}}[%s]{{/}} %s\n", resp.NodeName, resp.Line)
+	}
+	cancel() // receiver channel is closed, so cancel goroutine
+	<-donec
+	return nil
+}
 
-	color.Outf("{{green}}restart node response:{{/}} %+v\n", info)
+var startRevision uint64
+
+func newWatchEventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch-events [options]",
+		Short: "Watches the cluster events until interrupted.",
+		RunE:  watchEventsFunc,
+	}
+	cmd.PersistentFlags().Uint64Var(
+		&startRevision,
+		"start-revision",
+		0,
+		"revision to watch from (only new events, if zero)",
+	)
+	return cmd
+}
+
+func watchEventsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+
+	donec := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case sig := <-sigc:
+			zap.L().Warn("received signal", zap.String("signal", sig.String()))
+		case <-ctx.Done():
+		}
+		cancel()
+		close(donec)
+	}()
+
+	ch, err := cli.WatchEvents(ctx, client.WithStartRevision(startRevision), client.WithClusterName(clusterName))
+	if err != nil {
+		cancel()
+		<-donec
+		return err
+	}
+	for ev := range ch {
+		color.Outf("{{cyan}}[%d]{{/}} %s %s %s %s\n", ev.Revision, ev.ClusterName, ev.Type, ev.NodeName, ev.Message)
+	}
+	cancel() // receiver channel is closed, so cancel goroutine
+	<-donec
 	return nil
 }
 
-func newStopCommand() *cobra.Command {
+var (
+	waitHealthy      bool
+	waitNodeCount    uint32
+	waitBootstrapped []string
+	waitCChainHeight uint64
+	waitPChainHeight uint64
+	waitValidators   []string
+	waitTimeout      time.Duration
+	pollInterval     time.Duration
+)
+
+func newWaitCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "stop [options]",
-		Short: "Requests server stop.",
-		RunE:  stopFunc,
+		Use:   "wait [options]",
+		Short: "Waits until all the given conditions hold in the cluster.",
+		RunE:  waitFunc,
 	}
+	cmd.PersistentFlags().BoolVar(
+		&waitHealthy,
+		"healthy",
+		false,
+		"true to wait for all nodes to be healthy",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&waitNodeCount,
+		"node-count",
+		0,
+		"number of nodes to wait for, all healthy (0 to skip)",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&waitBootstrapped,
+		"bootstrapped",
+		nil,
+		"chain aliases or IDs to wait for all nodes to bootstrap",
+	)
+	cmd.PersistentFlags().Uint64Var(
+		&waitCChainHeight,
+		"c-chain-height",
+		0,
+		"C-chain height to wait for all nodes to accept (0 to skip)",
+	)
+	cmd.PersistentFlags().Uint64Var(
+		&waitPChainHeight,
+		"p-chain-height",
+		0,
+		"P-chain height to wait for all nodes to accept (0 to skip)",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&waitValidators,
+		"validator",
+		nil,
+		"node IDs or names to wait for to be current validators of the subnet",
+	)
+	cmd.PersistentFlags().StringVar(
+		&subnetID,
+		"subnet-id",
+		"",
+		"subnet ID of '--validator' (primary network, if empty)",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&waitTimeout,
+		"timeout",
+		5*time.Minute,
+		"time to wait for the conditions",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&pollInterval,
+		"poll-interval",
+		time.Second,
+		"interval between the checks",
+	)
 	return cmd
 }
 
-func stopFunc(cmd *cobra.Command, args []string) error {
+func waitFunc(cmd *cobra.Command, args []string) error {
+	var conds []*rpcpb.WaitCondition
+	if waitHealthy {
+		conds = append(conds, &rpcpb.WaitCondition{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_HEALTHY})
+	}
+	if waitNodeCount > 0 {
+		conds = append(conds, &rpcpb.WaitCondition{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_NODE_COUNT, NodeCount: waitNodeCount})
+	}
+	for _, chain := range waitBootstrapped {
+		conds = append(conds, &rpcpb.WaitCondition{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED, Chain: chain})
+	}
+	if waitCChainHeight > 0 {
+		conds = append(conds, &rpcpb.WaitCondition{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_C_CHAIN_HEIGHT, Height: waitCChainHeight})
+	}
+	if waitPChainHeight > 0 {
+		conds = append(conds, &rpcpb.WaitCondition{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_P_CHAIN_HEIGHT, Height: waitPChainHeight})
+	}
+	for _, nodeID := range waitValidators {
+		conds = append(conds, &rpcpb.WaitCondition{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_VALIDATOR, NodeId: nodeID, SubnetId: subnetID})
+	}
+	if len(conds) == 0 {
+		return errors.New("no condition to wait for")
+	}
+
 	cli, err := client.New(client.Config{
 		LogLevel:    logLevel,
 		Endpoint:    endpoint,
@@ -353,13 +902,1251 @@ func stopFunc(cmd *cobra.Command, args []string) error {
 	}
 	defer cli.Close()
 
-	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	info, err := cli.Stop(ctx)
-	cancel()
+	ctx, cancel := context.WithTimeout(context.Background(), waitTimeout+requestTimeout)
+	defer cancel()
+	_, err = cli.WaitFor(
+		ctx,
+		conds,
+		client.WithClusterName(clusterName),
+		client.WithWaitTimeout(waitTimeout),
+		client.WithPollInterval(pollInterval),
+		client.WithProgress(func(resp *rpcpb.WaitForResponse) {
+			for _, st := range resp.Statuses {
+				if st.Satisfied {
+					color.Outf("{{green}}%s{{/}}: %s\n", st.Condition.Type, st.Message)
+				} else {
+					color.Outf("{{cyan}}%s{{/}}: %s\n", st.Condition.Type, st.Message)
+				}
+			}
+		}),
+	)
 	if err != nil {
 		return err
 	}
+	color.Outf("{{green}}all conditions satisfied{{/}}\n")
+	return nil
+}
 
-	color.Outf("{{green}}stop response:{{/}} %+v\n", info)
+func newAddNodeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-node [options]",
+		Short: "Adds a new node.",
+		RunE:  addNodeFunc,
+	}
+	cmd.PersistentFlags().StringVar(&nodeName, "node-name", "", "node name to add")
+	cmd.PersistentFlags().StringVar(
+		&avalancheGoBinPath,
+		"avalanchego-path",
+		"",
+		"avalanchego binary path (defaults to the cluster binary)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&nodeConfig,
+		"node-config",
+		"",
+		"avalanchego config (JSON object) to merge over the defaults",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&validator,
+		"validator",
+		false,
+		"true to add the node as a primary network validator",
+	)
+	cmd.PersistentFlags().StringVar(
+		&stakingKeysDir,
+		"staking-keys-dir",
+		"",
+		"directory with the '<node name>/staking.{crt,key}' pair of the node (newly generated, if empty)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&chainConfigsDir,
+		"chain-configs-dir",
+		"",
+		"directory with the '<chain alias or ID>/{config,upgrade}.json' chain configs, merged over the cluster ones",
+	)
+	return cmd
+}
+
+func addNodeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	opts := []client.OpOption{
+		client.WithNodeConfig(nodeConfig),
+		client.WithValidator(validator),
+		client.WithClusterName(clusterName),
+	}
+	if stakingKeysDir != "" {
+		cert, key, err := readStakingKey(stakingKeysDir, nodeName)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithStakingKey(cert, key))
+	}
+	if chainConfigsDir != "" {
+		configs, err := readChainConfigs(chainConfigsDir)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithChainConfigs(configs))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.AddNode(ctx, nodeName, avalancheGoBinPath, opts...)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}add node response:{{/}} %+v\n", info)
+	return nil
+}
+
+func newRemoveNodeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-node [options]",
+		Short: "Removes a node.",
+		RunE:  removeNodeFunc,
+	}
+	cmd.PersistentFlags().StringVar(&nodeName, "node-name", "", "node name to remove")
+	return cmd
+}
+
+func removeNodeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.RemoveNode(ctx, nodeName, client.WithClusterName(clusterName))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}remove node response:{{/}} %+v\n", info)
+	return nil
+}
+
+func newPauseNodeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-node [options]",
+		Short: "Pauses a node (SIGSTOP).",
+		RunE:  pauseNodeFunc,
+	}
+	cmd.PersistentFlags().StringVar(&nodeName, "node-name", "", "node name to pause")
+	return cmd
+}
+
+func pauseNodeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.PauseNode(ctx, nodeName, client.WithClusterName(clusterName))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}pause node response:{{/}} %+v\n", info)
+	return nil
+}
+
+func newResumeNodeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-node [options]",
+		Short: "Resumes a paused node (SIGCONT).",
+		RunE:  resumeNodeFunc,
+	}
+	cmd.PersistentFlags().StringVar(&nodeName, "node-name", "", "node name to resume")
+	return cmd
+}
+
+func resumeNodeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.ResumeNode(ctx, nodeName, client.WithClusterName(clusterName))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}resume node response:{{/}} %+v\n", info)
+	return nil
+}
+
+var partitionGroups []string
+
+func newPartitionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partition [options]",
+		Short: "Cuts the traffic between node groups (not supported with avalanchego v1.7.2).",
+		RunE:  partitionFunc,
+	}
+	cmd.PersistentFlags().StringArrayVar(
+		&partitionGroups,
+		"group",
+		nil,
+		"comma-separated node names of a group (repeat for each group)",
+	)
+	return cmd
+}
+
+func partitionFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	groups := make([][]string, 0, len(partitionGroups))
+	for _, g := range partitionGroups {
+		groups = append(groups, strings.Split(g, ","))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.Partition(ctx, groups, client.WithClusterName(clusterName))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}partition response:{{/}} %+v\n", info)
+	return nil
+}
+
+func newHealPartitionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "heal-partition [options]",
+		Short: "Restores the traffic between node groups (not supported with avalanchego v1.7.2).",
+		RunE:  healPartitionFunc,
+	}
+	return cmd
+}
+
+func healPartitionFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.HealPartition(ctx, client.WithClusterName(clusterName))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}heal partition response:{{/}} %+v\n", info)
+	return nil
+}
+
+var (
+	linkFrom      string
+	linkTo        string
+	linkLatency   time.Duration
+	linkJitter    time.Duration
+	linkDropRate  float64
+	linkBandwidth uint64
+)
+
+func newSetLinkConditionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-link-conditions [options]",
+		Short: "Shapes the traffic from one node to another (not supported with avalanchego v1.7.2).",
+		RunE:  setLinkConditionsFunc,
+	}
+	cmd.PersistentFlags().StringVar(&linkFrom, "from", "", "node name the traffic comes from")
+	cmd.PersistentFlags().StringVar(&linkTo, "to", "", "node name the traffic goes to")
+	cmd.PersistentFlags().DurationVar(&linkLatency, "latency", 0, "added latency")
+	cmd.PersistentFlags().DurationVar(&linkJitter, "jitter", 0, "latency jitter")
+	cmd.PersistentFlags().Float64Var(&linkDropRate, "drop-rate", 0, "packet drop rate in [0, 1]")
+	cmd.PersistentFlags().Uint64Var(&linkBandwidth, "bandwidth", 0, "bytes per second (0 for unlimited)")
+	return cmd
+}
+
+func setLinkConditionsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.SetLinkConditions(ctx, &rpcpb.SetLinkConditionsRequest{
+		From:      linkFrom,
+		To:        linkTo,
+		LatencyMs: uint64(linkLatency.Milliseconds()),
+		JitterMs:  uint64(linkJitter.Milliseconds()),
+		DropRate:  linkDropRate,
+		Bandwidth: linkBandwidth,
+	}, client.WithClusterName(clusterName))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}set link conditions response:{{/}} %+v\n", info)
+	return nil
+}
+
+func newRestartNodeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restart-node [options]",
+		Short: "Restarts the server.",
+		RunE:  restartNodeFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&nodeName,
+		"node-name",
+		"",
+		"node name to restart",
+	)
+	cmd.PersistentFlags().StringVar(
+		&avalancheGoBinPath,
+		"avalanchego-path",
+		"",
+		"avalanchego binary path",
+	)
+	cmd.PersistentFlags().StringVar(
+		&whitelistedSubnets,
+		"whitelisted-subnets",
+		"",
+		"whitelisted subnets (comma-separated)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&nodeConfig,
+		"node-config",
+		"",
+		"avalanchego config (JSON object) to merge over the current node config",
+	)
+	cmd.PersistentFlags().StringVar(
+		&chainConfigsDir,
+		"chain-configs-dir",
+		"",
+		"directory with the '<chain alias or ID>/{config,upgrade}.json' chain configs to replace, per chain",
+	)
+	return cmd
+}
+
+func restartNodeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	opts := []client.OpOption{
+		client.WithWhitelistedSubnets(whitelistedSubnets),
+		client.WithNodeConfig(nodeConfig),
+		client.WithClusterName(clusterName),
+	}
+	if chainConfigsDir != "" {
+		configs, err := readChainConfigs(chainConfigsDir)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, client.WithChainConfigs(configs))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.RestartNode(ctx, nodeName, avalancheGoBinPath, opts...)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}restart node response:{{/}} %+v\n", info)
+	return nil
+}
+
+var (
+	upgradeOrder   string
+	maxUnavailable uint32
+	healthTimeout  time.Duration
+)
+
+func newRollingUpgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rolling-upgrade [options]",
+		Short: "Upgrades the nodes to a new binary, a few at a time.",
+		RunE:  rollingUpgradeFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&avalancheGoBinPath,
+		"avalanchego-path",
+		"",
+		"avalanchego binary path to upgrade to",
+	)
+	cmd.PersistentFlags().StringVar(
+		&upgradeOrder,
+		"node-names",
+		"",
+		"node names to upgrade in order (comma-separated, all nodes if empty)",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&maxUnavailable,
+		"max-unavailable",
+		1,
+		"number of nodes restarted at a time",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&healthTimeout,
+		"health-timeout",
+		2*time.Minute,
+		"time to wait for the network to be healthy after each step",
+	)
+	return cmd
+}
+
+func rollingUpgradeFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	opts := []client.OpOption{
+		client.WithMaxUnavailable(maxUnavailable),
+		client.WithHealthTimeout(healthTimeout),
+		client.WithClusterName(clusterName),
+	}
+	if upgradeOrder != "" {
+		opts = append(opts, client.WithUpgradeOrder(strings.Split(upgradeOrder, ",")...))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.RollingUpgrade(ctx, avalancheGoBinPath, opts...)
+	if err != nil {
+		return err
+	}
+	color.Outf("{{blue}}rolling upgrade operation %q started{{/}}\n", resp.Operation.Id)
+
+	op, err := cli.WaitOperation(ctx, resp.Operation.Id)
+	if err != nil {
+		return err
+	}
+	for _, nu := range op.GetRollingUpgradeReport().GetNodes() {
+		color.Outf("{{cyan}}%s{{/}}: %s %s\n", nu.Name, nu.State, nu.Error)
+	}
+	if op.State != rpcpb.OperationState_OPERATION_STATE_SUCCEEDED {
+		return fmt.Errorf("rolling upgrade %s: %s", op.State, op.Error)
+	}
+	color.Outf("{{green}}rolling upgrade succeeded{{/}}\n")
+	return nil
+}
+
+var subnetID string
+
+func newCreateSubnetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-subnet [options]",
+		Short: "Creates a subnet, and adds the nodes as its validators.",
+		RunE:  createSubnetFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&nodeName,
+		"node-name",
+		"",
+		"node names to validate the subnet (comma-separated, all nodes if empty)",
+	)
+	return cmd
+}
+
+func createSubnetFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	opts := []client.OpOption{client.WithClusterName(clusterName)}
+	if nodeName != "" {
+		opts = append(opts, client.WithNodeNames(strings.Split(nodeName, ",")...))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.CreateSubnet(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	color.Outf("{{blue}}create subnet operation %q started{{/}}\n", resp.Operation.Id)
+
+	op, err := cli.WaitOperation(ctx, resp.Operation.Id)
+	if err != nil {
+		return err
+	}
+	if op.State != rpcpb.OperationState_OPERATION_STATE_SUCCEEDED {
+		return fmt.Errorf("create subnet %s: %s", op.State, op.Error)
+	}
+	color.Outf("{{green}}created subnet:{{/}} %s\n", op.SubnetId)
+	return nil
+}
+
+func newAddSubnetValidatorsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-subnet-validators [options]",
+		Short: "Adds the nodes as the validators of an existing subnet.",
+		RunE:  addSubnetValidatorsFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&subnetID,
+		"subnet-id",
+		"",
+		"subnet ID",
+	)
+	cmd.PersistentFlags().StringVar(
+		&nodeName,
+		"node-name",
+		"",
+		"node names to validate the subnet (comma-separated, all nodes if empty)",
+	)
+	return cmd
+}
+
+func addSubnetValidatorsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	opts := []client.OpOption{client.WithClusterName(clusterName)}
+	if nodeName != "" {
+		opts = append(opts, client.WithNodeNames(strings.Split(nodeName, ",")...))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.AddSubnetValidators(ctx, subnetID, opts...)
+	if err != nil {
+		return err
+	}
+	color.Outf("{{blue}}add subnet validators operation %q started{{/}}\n", resp.Operation.Id)
+
+	op, err := cli.WaitOperation(ctx, resp.Operation.Id)
+	if err != nil {
+		return err
+	}
+	if op.State != rpcpb.OperationState_OPERATION_STATE_SUCCEEDED {
+		return fmt.Errorf("add subnet validators %s: %s", op.State, op.Error)
+	}
+	color.Outf("{{green}}added subnet validators{{/}}\n")
+	return nil
+}
+
+func newListSubnetsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-subnets [options]",
+		Short: "Lists the subnets and their validators.",
+		RunE:  listSubnetsFunc,
+	}
+	return cmd
+}
+
+func listSubnetsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	subnets, err := cli.ListSubnets(ctx, client.WithClusterName(clusterName))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	for _, subnet := range subnets {
+		color.Outf("{{green}}%s{{/}} (threshold %d, control keys %q)\n", subnet.Id, subnet.Threshold, subnet.ControlKeys)
+		color.Outf("  {{cyan}}validators:{{/}} %q %q\n", subnet.ValidatorNodeNames, subnet.ValidatorNodeIds)
+	}
+	return nil
+}
+
+var (
+	vmPath        string
+	vmID          string
+	vmName        string
+	vmGenesisPath string
+	chainName     string
+)
+
+func newCreateBlockchainCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-blockchain [options]",
+		Short: "Deploys a VM plugin to the nodes, and creates a blockchain of it.",
+		RunE:  createBlockchainFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&vmPath,
+		"vm-path",
+		"",
+		"VM plugin binary path",
+	)
+	cmd.PersistentFlags().StringVar(
+		&vmName,
+		"vm-name",
+		"",
+		"VM name",
+	)
+	cmd.PersistentFlags().StringVar(
+		&vmID,
+		"vm-id",
+		"",
+		"VM ID (derived from the VM name if empty)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&vmGenesisPath,
+		"genesis-path",
+		"",
+		"blockchain genesis file path",
+	)
+	cmd.PersistentFlags().StringVar(
+		&subnetID,
+		"subnet-id",
+		"",
+		"subnet ID (creates a new subnet if empty)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&nodeName,
+		"node-name",
+		"",
+		"node names to validate the subnet (comma-separated, all nodes of a new subnet if empty)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&chainName,
+		"chain-name",
+		"",
+		"blockchain name (the VM name if empty)",
+	)
+	return cmd
+}
+
+func createBlockchainFunc(cmd *cobra.Command, args []string) error {
+	genesis, err := os.ReadFile(vmGenesisPath)
+	if err != nil {
+		return err
+	}
+
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	opts := []client.OpOption{
+		client.WithClusterName(clusterName),
+		client.WithVMID(vmID),
+		client.WithSubnetID(subnetID),
+		client.WithChainName(chainName),
+	}
+	if nodeName != "" {
+		opts = append(opts, client.WithNodeNames(strings.Split(nodeName, ",")...))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.CreateBlockchain(ctx, vmPath, vmName, genesis, opts...)
+	if err != nil {
+		return err
+	}
+	color.Outf("{{blue}}create blockchain operation %q started{{/}}\n", resp.Operation.Id)
+
+	op, err := cli.WaitOperation(ctx, resp.Operation.Id)
+	if err != nil {
+		return err
+	}
+	if op.State != rpcpb.OperationState_OPERATION_STATE_SUCCEEDED {
+		return fmt.Errorf("create blockchain %s: %s", op.State, op.Error)
+	}
+	for _, bc := range op.ClusterInfo.Blockchains {
+		if bc.Id != op.BlockchainId {
+			continue
+		}
+		color.Outf("{{green}}created blockchain %q in subnet %q{{/}}\n", bc.Id, bc.SubnetId)
+		for _, name := range bc.NodeNames {
+			color.Outf("  {{cyan}}%s:{{/}} %s\n", name, bc.RpcUrls[name])
+		}
+	}
+	return nil
+}
+
+var (
+	allAtOnce      bool
+	blockTimeout   time.Duration
+	skipBlockCheck bool
+)
+
+func newUpgradeVMCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-vm [options]",
+		Short: "Replaces a VM plugin in the nodes, and restarts them.",
+		RunE:  upgradeVMFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&vmID,
+		"vm-id",
+		"",
+		"VM ID or name to upgrade",
+	)
+	cmd.PersistentFlags().StringVar(
+		&vmPath,
+		"vm-path",
+		"",
+		"VM plugin binary path to upgrade to",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&allAtOnce,
+		"all-at-once",
+		false,
+		"true to restart all nodes at once, instead of one at a time",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&healthTimeout,
+		"health-timeout",
+		2*time.Minute,
+		"time to wait for the network to be healthy after each step",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&blockTimeout,
+		"block-timeout",
+		time.Minute,
+		"time to wait for each chain of the VM to accept a new block",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&skipBlockCheck,
+		"skip-block-check",
+		false,
+		"true to skip waiting for the chains to accept a new block",
+	)
+	return cmd
+}
+
+func upgradeVMFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.UpgradeVM(
+		ctx,
+		vmID,
+		vmPath,
+		client.WithClusterName(clusterName),
+		client.WithAllAtOnce(allAtOnce),
+		client.WithHealthTimeout(healthTimeout),
+		client.WithBlockTimeout(blockTimeout),
+		client.WithSkipBlockCheck(skipBlockCheck),
+	)
+	if err != nil {
+		return err
+	}
+	color.Outf("{{blue}}upgrade VM operation %q started{{/}}\n", resp.Operation.Id)
+
+	op, err := cli.WaitOperation(ctx, resp.Operation.Id)
+	if err != nil {
+		return err
+	}
+	for _, nu := range op.GetRollingUpgradeReport().GetNodes() {
+		color.Outf("{{cyan}}%s{{/}}: %s %s\n", nu.Name, nu.State, nu.Error)
+	}
+	if op.State != rpcpb.OperationState_OPERATION_STATE_SUCCEEDED {
+		return fmt.Errorf("upgrade VM %s: %s", op.State, op.Error)
+	}
+	color.Outf("{{green}}upgrade VM succeeded{{/}}\n")
+	return nil
+}
+
+func newStopCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop [options]",
+		Short: "Requests server stop.",
+		RunE:  stopFunc,
+	}
+	return cmd
+}
+
+func stopFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.Stop(ctx, client.WithClusterName(clusterName))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}stop response:{{/}} %+v\n", info)
+	return nil
+}
+
+var snapshotName string
+
+func newListClustersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-clusters [options]",
+		Short: "Lists the clusters managed by the server.",
+		RunE:  listClustersFunc,
+	}
+	return cmd
+}
+
+func listClustersFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.ListClusters(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}clusters:{{/}} %q\n", resp.ClusterNames)
+	return nil
+}
+
+func newSaveSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save-snapshot [options]",
+		Short: "Stops the cluster and saves its state as a snapshot.",
+		RunE:  saveSnapshotFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&snapshotName,
+		"snapshot-name",
+		"",
+		"snapshot name",
+	)
+	return cmd
+}
+
+func saveSnapshotFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	resp, err := cli.SaveSnapshot(ctx, snapshotName, client.WithClusterName(clusterName))
+	if err != nil {
+		return err
+	}
+	color.Outf("{{blue}}save snapshot operation %q started{{/}}\n", resp.Operation.Id)
+
+	op, err := cli.WaitOperation(ctx, resp.Operation.Id)
+	if err != nil {
+		return err
+	}
+	if op.State != rpcpb.OperationState_OPERATION_STATE_SUCCEEDED {
+		return fmt.Errorf("save snapshot %s: %s", op.State, op.Error)
+	}
+	color.Outf("{{green}}saved snapshot:{{/}} %q\n", resp.SnapshotPath)
+	return nil
+}
+
+func newLoadSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "load-snapshot [options]",
+		Short: "Starts a new cluster from a snapshot.",
+		RunE:  loadSnapshotFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&snapshotName,
+		"snapshot-name",
+		"",
+		"snapshot name",
+	)
+	cmd.PersistentFlags().StringVar(
+		&avalancheGoBinPath,
+		"avalanchego-path",
+		"",
+		"avalanchego binary path (overrides the binaries in the snapshot)",
+	)
+	return cmd
+}
+
+func loadSnapshotFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.LoadSnapshot(
+		ctx,
+		snapshotName,
+		client.WithClusterName(clusterName),
+		client.WithExecPath(avalancheGoBinPath),
+	)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}load snapshot response:{{/}} %+v\n", info)
+	return nil
+}
+
+func newListSnapshotsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-snapshots [options]",
+		Short: "Lists the saved snapshots.",
+		RunE:  listSnapshotsFunc,
+	}
+	return cmd
+}
+
+func listSnapshotsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	names, err := cli.ListSnapshots(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}snapshots:{{/}} %q\n", names)
+	return nil
+}
+
+func newRemoveSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-snapshot [options]",
+		Short: "Removes a snapshot.",
+		RunE:  removeSnapshotFunc,
+	}
+	cmd.PersistentFlags().StringVar(
+		&snapshotName,
+		"snapshot-name",
+		"",
+		"snapshot name",
+	)
+	return cmd
+}
+
+func removeSnapshotFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	err = cli.RemoveSnapshot(ctx, snapshotName)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}removed snapshot:{{/}} %q\n", snapshotName)
+	return nil
+}
+
+var operationID string
+
+func newGetOperationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-operation [options]",
+		Short: "Gets an operation.",
+		RunE:  getOperationFunc,
+	}
+	cmd.PersistentFlags().StringVar(&operationID, "operation-id", "", "operation ID")
+	return cmd
+}
+
+func getOperationFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	op, err := cli.GetOperation(ctx, operationID)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}operation:{{/}} %+v\n", op)
+	return nil
+}
+
+func newWaitOperationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait-operation [options]",
+		Short: "Waits until an operation is done.",
+		RunE:  waitOperationFunc,
+	}
+	cmd.PersistentFlags().StringVar(&operationID, "operation-id", "", "operation ID")
+	return cmd
+}
+
+func waitOperationFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	op, err := cli.WaitOperation(ctx, operationID)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}operation:{{/}} %+v\n", op)
+	return nil
+}
+
+func newCancelOperationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-operation [options]",
+		Short: "Cancels an operation.",
+		RunE:  cancelOperationFunc,
+	}
+	cmd.PersistentFlags().StringVar(&operationID, "operation-id", "", "operation ID")
+	return cmd
+}
+
+func cancelOperationFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	op, err := cli.CancelOperation(ctx, operationID)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	color.Outf("{{green}}canceled operation:{{/}} %+v\n", op)
+	return nil
+}
+
+func newListOperationsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-operations [options]",
+		Short: "Lists the operations (of all clusters if \"--cluster\" is not set).",
+		RunE:  listOperationsFunc,
+	}
+	return cmd
+}
+
+func listOperationsFunc(cmd *cobra.Command, args []string) error {
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    endpoint,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	ops, err := cli.ListOperations(ctx, client.WithClusterName(clusterName))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		color.Outf("{{green}}operation:{{/}} %s %s %q %s %s\n", op.Id, op.Type, op.ClusterName, op.State, op.Error)
+	}
+	return nil
+}
+
+const (
+	stakingCertFile = "staking.crt"
+	stakingKeyFile  = "staking.key"
+)
+
+var (
+	numKeys       uint32
+	keysOutputDir string
+	keysPrefix    string
+)
+
+func newKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manages the node staking keys.",
+	}
+	cmd.AddCommand(newKeysGenerateCommand())
+	return cmd
+}
+
+func newKeysGenerateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate [options]",
+		Short: "Generates staking key pairs, and prints their node IDs.",
+		RunE:  keysGenerateFunc,
+	}
+	cmd.PersistentFlags().Uint32Var(&numKeys, "count", 1, "number of key pairs to generate")
+	cmd.PersistentFlags().StringVar(&keysOutputDir, "output-dir", ".", "directory to write the '<node name>/staking.{crt,key}' pairs to")
+	cmd.PersistentFlags().StringVar(&keysPrefix, "name-prefix", "node", "node name prefix, followed by 1, 2, ...")
+	return cmd
+}
+
+func keysGenerateFunc(cmd *cobra.Command, args []string) error {
+	for i := 1; i <= int(numKeys); i++ {
+		name := fmt.Sprintf("%s%d", keysPrefix, i)
+		dir := filepath.Join(keysOutputDir, name)
+		if _, err := os.Stat(filepath.Join(dir, stakingKeyFile)); err == nil {
+			return fmt.Errorf("staking key already exists in %q", dir)
+		}
+		cert, key, err := staking.NewCertAndKeyBytes()
+		if err != nil {
+			return err
+		}
+		nodeID, err := utils.ToNodeID(key, cert)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, stakingCertFile), cert, 0o644); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, stakingKeyFile), key, 0o600); err != nil {
+			return err
+		}
+		color.Outf("{{green}}%s:{{/}} %s (%s)\n", name, nodeID.PrefixedString(constants.NodeIDPrefix), dir)
+	}
 	return nil
 }
@@ -22,10 +22,12 @@ func init() {
 }
 
 var (
-	logLevel    string
-	port        string
-	gwPort      string
-	dialTimeout time.Duration
+	logLevel     string
+	port         string
+	gwPort       string
+	metricsPort  string
+	dialTimeout  time.Duration
+	snapshotsDir string
 )
 
 func NewCommand() *cobra.Command {
@@ -38,7 +40,9 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&logLevel, "log-level", logutil.DefaultLogLevel, "log level")
 	cmd.PersistentFlags().StringVar(&port, "port", ":8080", "server port")
 	cmd.PersistentFlags().StringVar(&gwPort, "grpc-gateway-port", ":8081", "grpc-gateway server port")
+	cmd.PersistentFlags().StringVar(&metricsPort, "metrics-port", "", "Prometheus metrics server port (defaults to serving \"/metrics\" on the grpc-gateway port)")
 	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", 10*time.Second, "server dial timeout")
+	cmd.PersistentFlags().StringVar(&snapshotsDir, "snapshots-dir", "", "snapshots directory (defaults to \"$HOME/.avalanche-network-runner/snapshots\")")
 
 	return cmd
 }
@@ -53,9 +57,11 @@ func serverFunc(cmd *cobra.Command, args []string) (err error) {
 	_ = zap.ReplaceGlobals(logger)
 
 	s, err := server.New(server.Config{
-		Port:        port,
-		GwPort:      gwPort,
-		DialTimeout: dialTimeout,
+		Port:         port,
+		GwPort:       gwPort,
+		MetricsPort:  metricsPort,
+		DialTimeout:  dialTimeout,
+		SnapshotsDir: snapshotsDir,
 	})
 	if err != nil {
 		return err
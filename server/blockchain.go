@@ -0,0 +1,583 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/gyuho/avax-tester/pkg/color"
+	"github.com/gyuho/avax-tester/rpcpb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	buildDirKey    = "build-dir"
+	pluginsDirName = "plugins"
+
+	chainBootstrapWait = 5 * time.Minute
+	defaultBlockWait   = time.Minute
+)
+
+var (
+	ErrInvalidVM     = errors.New("invalid VM")
+	ErrVMNotFound    = errors.New("VM not found")
+	ErrNoSubnetNodes = errors.New("no node validates the subnet")
+)
+
+// toVMID returns [id] if set, or the ID derived from [name] zero-padded
+// to 32 bytes, as the VM aliases are.
+func toVMID(id string, name string) (ids.ID, error) {
+	if id != "" {
+		vmID, err := ids.FromString(id)
+		if err != nil {
+			return ids.Empty, fmt.Errorf("%w: VM ID %q: %v", ErrInvalidVM, id, err)
+		}
+		return vmID, nil
+	}
+	if name == "" || len(name) > len(ids.Empty) {
+		return ids.Empty, fmt.Errorf("%w: VM name %q must be 1 to %d bytes", ErrInvalidVM, name, len(ids.Empty))
+	}
+	b := make([]byte, len(ids.Empty))
+	copy(b, name)
+	return ids.ToID(b)
+}
+
+// nodeBuildDir is the build directory of the node, with its own plugin
+// directory, once it runs a custom VM.
+func (lc *localNetwork) nodeBuildDir(name string) string {
+	return filepath.Join(lc.rootDataDir, name, "build")
+}
+
+// linkPlugins links the plugins next to [execPath] (e.g., "evm") into
+// the node plugin directory, if [configFile] sets the node build directory.
+// The VM plugins copied into the directory are kept.
+func (lc *localNetwork) linkPlugins(name string, configFile []byte, execPath string) error {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(configFile, &m); err != nil {
+		return err
+	}
+	buildDir := lc.nodeBuildDir(name)
+	if v, ok := m[buildDirKey].(string); !ok || v != buildDir {
+		return nil
+	}
+
+	pluginDir := filepath.Join(buildDir, pluginsDirName)
+	if err := os.MkdirAll(pluginDir, 0o750); err != nil {
+		return err
+	}
+	entries, err := ioutil.ReadDir(pluginDir)
+	if err != nil {
+		return err
+	}
+	// remove the links to the previous binary
+	for _, e := range entries {
+		if e.Mode()&os.ModeSymlink != 0 {
+			if err := os.Remove(filepath.Join(pluginDir, e.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	src, err := filepath.Abs(filepath.Join(filepath.Dir(execPath), pluginsDirName))
+	if err != nil {
+		return err
+	}
+	entries, err = ioutil.ReadDir(src)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		target := filepath.Join(pluginDir, e.Name())
+		if _, err := os.Lstat(target); err == nil {
+			// copied VM plugin
+			continue
+		}
+		if err := os.Symlink(filepath.Join(src, e.Name()), target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// installPlugin copies [vmPath] into the node plugin directory as [vmID].
+// Returns the node config to restart the node with, or empty if the node
+// already runs the same plugin.
+func (lc *localNetwork) installPlugin(name string, vmID ids.ID, vmPath string) (string, error) {
+	nodeInfo := lc.nodeInfos[name]
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(nodeInfo.Config, &m); err != nil {
+		return "", err
+	}
+	buildDir := lc.nodeBuildDir(name)
+	config := fmt.Sprintf(`{%q:%q}`, buildDirKey, buildDir)
+	changed := m[buildDirKey] != buildDir
+
+	target := filepath.Join(buildDir, pluginsDirName, vmID.String())
+	b, err := ioutil.ReadFile(vmPath)
+	if err != nil {
+		return "", err
+	}
+	if prev, err := ioutil.ReadFile(target); err == nil && bytes.Equal(prev, b) {
+		if changed {
+			return config, nil
+		}
+		return "", nil
+	}
+
+	color.Outf("{{blue}}installing VM %q plugin in %q{{/}}\n", vmID, name)
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return "", err
+	}
+	// the running plugin binary cannot be written to
+	tmp := target + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0o755); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, target); err != nil {
+		return "", err
+	}
+	return config, nil
+}
+
+// subnetNodes returns the nodes that whitelist [subnetID].
+func (lc *localNetwork) subnetNodes(subnetID string) []string {
+	var names []string
+	for _, name := range lc.nodeNames {
+		if isWhitelisted(lc.nodeInfos[name].WhitelistedSubnets, subnetID) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+type blockchainOptions struct {
+	vmID      ids.ID
+	vmName    string
+	vmPath    string
+	genesis   []byte
+	subnetID  string
+	chainName string
+}
+
+// createBlockchain installs the VM plugin in the nodes that validate the
+// subnet, restarting the nodes whose plugins changed one at a time, and
+// creates the blockchain. Waits until all of the nodes bootstrap the chain.
+// [onCreated] is called with the blockchain ID once the tx is committed.
+func (lc *localNetwork) createBlockchain(ctx context.Context, opts blockchainOptions, onCreated func(string)) error {
+	nodeNames := lc.subnetNodes(opts.subnetID)
+	if len(nodeNames) == 0 {
+		return fmt.Errorf("%w: %q", ErrNoSubnetNodes, opts.subnetID)
+	}
+	subnetID, err := ids.FromString(opts.subnetID)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrSubnetNotFound, opts.subnetID)
+	}
+
+	for _, name := range nodeNames {
+		config, err := lc.installPlugin(name, opts.vmID, opts.vmPath)
+		if err != nil {
+			return fmt.Errorf("node %q: %w", name, err)
+		}
+		if config == "" {
+			continue
+		}
+		color.Outf("{{blue}}restarting %q with the VM %q plugin{{/}}\n", name, opts.vmID)
+		if err := lc.restartNode(ctx, name, "", lc.nodeInfos[name].WhitelistedSubnets, config); err != nil {
+			return err
+		}
+	}
+
+	cli, addr, err := lc.pChainWallet()
+	if err != nil {
+		return err
+	}
+	color.Outf("{{blue}}creating blockchain %q in subnet %q{{/}}\n", opts.chainName, opts.subnetID)
+	txID, err := cli.PChainAPI().CreateBlockchain(
+		ewoqUser,
+		[]string{addr},
+		addr,
+		subnetID,
+		opts.vmID.String(),
+		nil,
+		opts.chainName,
+		opts.genesis,
+	)
+	if err != nil {
+		return err
+	}
+	if err := waitForTxCommitted(ctx, cli.PChainAPI(), txID); err != nil {
+		return err
+	}
+	chainID := txID.String()
+	lc.blockchains = append(lc.blockchains, &rpcpb.BlockchainInfo{
+		Id:        chainID,
+		Name:      opts.chainName,
+		VmId:      opts.vmID.String(),
+		VmName:    opts.vmName,
+		SubnetId:  opts.subnetID,
+		NodeNames: nodeNames,
+	})
+	onCreated(chainID)
+
+	return lc.waitForBootstrapped(ctx, chainID, nodeNames)
+}
+
+// waitForBootstrapped polls the nodes until all of them report [chainID]
+// bootstrapped.
+func (lc *localNetwork) waitForBootstrapped(ctx context.Context, chainID string, nodeNames []string) error {
+	color.Outf("{{blue}}waiting for %d nodes to bootstrap %q...{{/}}\n", len(nodeNames), chainID)
+	ctx, cancel := context.WithTimeout(ctx, chainBootstrapWait)
+	defer cancel()
+	pending := append([]string(nil), nodeNames...)
+	for {
+		var left []string
+		for _, name := range pending {
+			cli, ok := lc.apiClis[name]
+			if !ok {
+				left = append(left, name)
+				continue
+			}
+			if bootstrapped, err := cli.InfoAPI().IsBootstrapped(chainID); err != nil || !bootstrapped {
+				left = append(left, name)
+			}
+		}
+		if pending = left; len(pending) == 0 {
+			color.Outf("{{green}}blockchain %q bootstrapped{{/}}\n", chainID)
+			return nil
+		}
+		select {
+		case <-lc.stopc:
+			return errAborted
+		case <-ctx.Done():
+			return fmt.Errorf("blockchain %q not bootstrapped in %q: %w", chainID, pending, ctx.Err())
+		case <-time.After(txPollInterval):
+		}
+	}
+}
+
+// blockchainInfos returns copies of the blockchains, with the RPC URLs of
+// the nodes that have an API endpoint.
+func (lc *localNetwork) blockchainInfos() []*rpcpb.BlockchainInfo {
+	infos := make([]*rpcpb.BlockchainInfo, 0, len(lc.blockchains))
+	for _, bc := range lc.blockchains {
+		info := proto.Clone(bc).(*rpcpb.BlockchainInfo)
+		info.RpcUrls = make(map[string]string, len(info.NodeNames))
+		for _, name := range info.NodeNames {
+			nodeInfo, ok := lc.nodeInfos[name]
+			if !ok || nodeInfo.Uri == "" {
+				continue
+			}
+			info.RpcUrls[name] = nodeInfo.Uri + "/ext/bc/" + info.Id
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+func (s *server) CreateBlockchain(ctx context.Context, req *rpcpb.CreateBlockchainRequest) (*rpcpb.CreateBlockchainResponse, error) {
+	zap.L().Debug("received create blockchain request", zap.String("vm-path", req.GetVmPath()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNodeNames(c.getInfo(), req.GetNodeNames()); err != nil {
+		return nil, err
+	}
+	vmID, err := toVMID(req.GetVmId(), req.GetVmName())
+	if err != nil {
+		return nil, err
+	}
+	if fi, err := os.Stat(req.GetVmPath()); err != nil || !fi.Mode().IsRegular() {
+		return nil, fmt.Errorf("%w: VM plugin %q not found", ErrInvalidVM, req.GetVmPath())
+	}
+	if len(req.GetGenesis()) == 0 {
+		return nil, fmt.Errorf("%w: empty blockchain genesis", ErrInvalidGenesis)
+	}
+	if req.GetSubnetId() != "" {
+		if _, err := ids.FromString(req.GetSubnetId()); err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrSubnetNotFound, req.GetSubnetId())
+		}
+	}
+	opts := blockchainOptions{
+		vmID:      vmID,
+		vmName:    req.GetVmName(),
+		vmPath:    req.GetVmPath(),
+		genesis:   req.GetGenesis(),
+		subnetID:  req.GetSubnetId(),
+		chainName: req.GetChainName(),
+	}
+	if opts.chainName == "" {
+		opts.chainName = opts.vmName
+	}
+
+	op := s.ops.run(s.rootCtx, "CreateBlockchain", c, func(ctx context.Context, o *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
+
+		if opts.subnetID == "" {
+			opts.subnetID, err = nw.createSubnet(ctx)
+			if err != nil {
+				return err
+			}
+			c.publish(nw)
+		}
+		o.update(func(op *rpcpb.Operation) {
+			op.SubnetId = opts.subnetID
+		})
+		if req.GetSubnetId() == "" || len(req.GetNodeNames()) > 0 {
+			err = nw.addSubnetValidators(ctx, opts.subnetID, req.GetNodeNames())
+			c.publish(nw)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = nw.createBlockchain(ctx, opts, func(chainID string) {
+			c.publish(nw)
+			o.update(func(op *rpcpb.Operation) {
+				op.BlockchainId = chainID
+			})
+		})
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.CreateBlockchainResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
+
+type vmUpgradeOptions struct {
+	// VM ID or name
+	vmID          string
+	vmPath        string
+	allAtOnce     bool
+	healthTimeout time.Duration
+	// zero to skip waiting for a new block
+	blockTimeout time.Duration
+}
+
+// vmBlockchains returns the blockchains of the VM [vmID] (ID or name),
+// and the nodes that run them.
+func (lc *localNetwork) vmBlockchains(vmID string) ([]*rpcpb.BlockchainInfo, []string) {
+	var chains []*rpcpb.BlockchainInfo
+	running := make(map[string]struct{})
+	for _, bc := range lc.blockchains {
+		if bc.VmId != vmID && bc.VmName != vmID {
+			continue
+		}
+		chains = append(chains, bc)
+		for _, name := range bc.NodeNames {
+			running[name] = struct{}{}
+		}
+	}
+	var nodeNames []string
+	for _, name := range lc.nodeNames {
+		if _, ok := running[name]; ok {
+			nodeNames = append(nodeNames, name)
+		}
+	}
+	return chains, nodeNames
+}
+
+// upgradeVM replaces the VM plugin in the nodes that run its blockchains,
+// and restarts them one at a time, or all at once if [opts.allAtOnce].
+// Then waits for the chains to be bootstrapped, and to accept new blocks.
+// [progress] is called with a copy of the report after each change.
+func (lc *localNetwork) upgradeVM(ctx context.Context, opts vmUpgradeOptions, progress func(*rpcpb.RollingUpgradeReport)) error {
+	chains, nodeNames := lc.vmBlockchains(opts.vmID)
+	if len(chains) == 0 || len(nodeNames) == 0 {
+		return fmt.Errorf("%w: %q", ErrVMNotFound, opts.vmID)
+	}
+	vmID, err := ids.FromString(chains[0].VmId)
+	if err != nil {
+		return err
+	}
+
+	report := &rpcpb.RollingUpgradeReport{ExecPath: opts.vmPath}
+	for _, name := range nodeNames {
+		report.Nodes = append(report.Nodes, &rpcpb.NodeUpgrade{
+			Name:  name,
+			State: rpcpb.UpgradeState_UPGRADE_STATE_PENDING,
+		})
+	}
+	progress(proto.Clone(report).(*rpcpb.RollingUpgradeReport))
+
+	if err := lc.waitForHealthyWithin(ctx, opts.healthTimeout); err != nil {
+		return fmt.Errorf("network not healthy before upgrade: %w", err)
+	}
+
+	step := 1
+	if opts.allAtOnce {
+		step = len(report.Nodes)
+	}
+	for start := 0; start < len(report.Nodes); start += step {
+		end := start + step
+		if end > len(report.Nodes) {
+			end = len(report.Nodes)
+		}
+
+		batch := make([]*rpcpb.NodeUpgrade, 0, end-start)
+		for _, nu := range report.Nodes[start:end] {
+			nu.StartTime = time.Now().UnixNano()
+			config, err := lc.installPlugin(nu.Name, vmID, opts.vmPath)
+			if err == nil && config == "" {
+				nu.State = rpcpb.UpgradeState_UPGRADE_STATE_SKIPPED
+				continue
+			}
+			if err == nil {
+				color.Outf("{{blue}}{{bold}}restarting node %q with the new VM %q plugin{{/}}\n", nu.Name, vmID)
+				err = lc.replaceNode(nu.Name, "", lc.nodeInfos[nu.Name].WhitelistedSubnets, config)
+			}
+			if err != nil {
+				nu.State = rpcpb.UpgradeState_UPGRADE_STATE_FAILED
+				nu.Error = err.Error()
+				nu.EndTime = time.Now().UnixNano()
+				progress(proto.Clone(report).(*rpcpb.RollingUpgradeReport))
+				return fmt.Errorf("failed to upgrade VM in node %q: %w", nu.Name, err)
+			}
+			batch = append(batch, nu)
+		}
+		if len(batch) == 0 {
+			progress(proto.Clone(report).(*rpcpb.RollingUpgradeReport))
+			continue
+		}
+
+		err := lc.waitForHealthyWithin(ctx, opts.healthTimeout)
+		now := time.Now().UnixNano()
+		for _, nu := range batch {
+			nu.EndTime = now
+			if err != nil {
+				nu.State = rpcpb.UpgradeState_UPGRADE_STATE_FAILED
+				nu.Error = err.Error()
+				continue
+			}
+			nu.State = rpcpb.UpgradeState_UPGRADE_STATE_UPGRADED
+		}
+		progress(proto.Clone(report).(*rpcpb.RollingUpgradeReport))
+		if err != nil {
+			return fmt.Errorf("network not healthy after upgrading VM: %w", err)
+		}
+	}
+
+	for _, bc := range chains {
+		if err := lc.waitForBootstrapped(ctx, bc.Id, bc.NodeNames); err != nil {
+			return err
+		}
+		if opts.blockTimeout == 0 {
+			continue
+		}
+		if err := lc.waitForAcceptedBlock(ctx, bc.Id, bc.NodeNames, opts.blockTimeout); err != nil {
+			return err
+		}
+	}
+	color.Outf("{{green}}{{bold}}upgraded VM %q to %q{{/}}\n", vmID, opts.vmPath)
+	return nil
+}
+
+// waitForAcceptedBlock waits until a node in [nodeNames] accepts a new
+// block in [chainID], from the consensus metrics of the nodes.
+func (lc *localNetwork) waitForAcceptedBlock(ctx context.Context, chainID string, nodeNames []string, timeout time.Duration) error {
+	color.Outf("{{blue}}waiting for %q to accept a new block...{{/}}\n", chainID)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	metricName := fmt.Sprintf("avalanche_%s_blks_accepted_count", chainID)
+	accepted := func() map[string]float64 {
+		counts := make(map[string]float64, len(nodeNames))
+		for _, name := range nodeNames {
+			mfs, err := scrapeNode(ctx, lc.nodeInfos[name].Uri)
+			if err != nil {
+				continue
+			}
+			if mf, ok := mfs[metricName]; ok {
+				for _, sample := range toMetricFamily(mf).Samples {
+					counts[name] += sample.Value
+				}
+			}
+		}
+		return counts
+	}
+
+	base := accepted()
+	for {
+		select {
+		case <-lc.stopc:
+			return errAborted
+		case <-ctx.Done():
+			return fmt.Errorf("blockchain %q accepted no block: %w", chainID, ctx.Err())
+		case <-time.After(txPollInterval):
+		}
+		for name, count := range accepted() {
+			// counters reset when the node restarts
+			if prev, ok := base[name]; ok && count > prev {
+				color.Outf("{{green}}blockchain %q accepted a new block in %q{{/}}\n", chainID, name)
+				return nil
+			}
+		}
+	}
+}
+
+func (s *server) UpgradeVM(ctx context.Context, req *rpcpb.UpgradeVMRequest) (*rpcpb.UpgradeVMResponse, error) {
+	zap.L().Debug("received upgrade VM request", zap.String("vm-id", req.GetVmId()), zap.String("vm-path", req.GetVmPath()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetVmId() == "" {
+		return nil, fmt.Errorf("%w: empty VM ID", ErrInvalidVM)
+	}
+	if fi, err := os.Stat(req.GetVmPath()); err != nil || !fi.Mode().IsRegular() {
+		return nil, fmt.Errorf("%w: VM plugin %q not found", ErrInvalidVM, req.GetVmPath())
+	}
+	opts := vmUpgradeOptions{
+		vmID:          req.GetVmId(),
+		vmPath:        req.GetVmPath(),
+		allAtOnce:     req.GetAllAtOnce(),
+		healthTimeout: time.Duration(req.GetHealthTimeout()),
+		blockTimeout:  time.Duration(req.GetBlockTimeout()),
+	}
+	if opts.healthTimeout == 0 {
+		opts.healthTimeout = healthyWait
+	}
+	switch {
+	case req.GetSkipBlockCheck():
+		opts.blockTimeout = 0
+	case opts.blockTimeout == 0:
+		opts.blockTimeout = defaultBlockWait
+	}
+
+	op := s.ops.run(s.rootCtx, "UpgradeVM", c, func(ctx context.Context, o *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
+
+		err = nw.upgradeVM(ctx, opts, func(report *rpcpb.RollingUpgradeReport) {
+			c.publish(nw)
+			o.update(func(op *rpcpb.Operation) {
+				op.RollingUpgradeReport = report
+			})
+		})
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.UpgradeVMResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
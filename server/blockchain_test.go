@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestToVMID(t *testing.T) {
+	vmID, err := toVMID("", "timestampvm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, len(ids.Empty))
+	copy(b, "timestampvm")
+	if expected, _ := ids.ToID(b); vmID != expected {
+		t.Fatalf("expected VM ID %q, got %q", expected, vmID)
+	}
+	// the ID takes precedence over the name
+	id, err := toVMID(vmID.String(), "othervm")
+	if err != nil || id != vmID {
+		t.Fatalf("unexpected VM ID %q, %v", id, err)
+	}
+
+	for _, tv := range []struct{ id, name string }{
+		{id: "invalid"},
+		{name: ""},
+		{name: "a-vm-name-longer-than-thirty-two-bytes"},
+	} {
+		if _, err := toVMID(tv.id, tv.name); !errors.Is(err, ErrInvalidVM) {
+			t.Fatalf("%+v: expected %v, got %v", tv, ErrInvalidVM, err)
+		}
+	}
+}
+
+func TestInstallPlugin(t *testing.T) {
+	lc := newTestNetwork(t, newFakeBinary(t), 1, nil)
+	vmID, err := toVMID("", "testvm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vmPath := filepath.Join(t.TempDir(), "testvm")
+	if err := ioutil.WriteFile(vmPath, []byte("v1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := lc.installPlugin("node1", vmID, vmPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config == "" {
+		t.Fatal("node not restarted with its build directory")
+	}
+	target := filepath.Join(lc.nodeBuildDir("node1"), pluginsDirName, vmID.String())
+	if b, err := ioutil.ReadFile(target); err != nil || string(b) != "v1" {
+		t.Fatalf("plugin not installed: %q, %v", b, err)
+	}
+
+	// no restart once the node runs the plugin
+	configFile, err := mergeConfig(nil, string(lc.nodeInfos["node1"].Config), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := updateNodeInfo(lc.nodeInfos["node1"], configFile); err != nil {
+		t.Fatal(err)
+	}
+	if config, err = lc.installPlugin("node1", vmID, vmPath); err != nil || config != "" {
+		t.Fatalf("unexpected config %q, %v", config, err)
+	}
+
+	// upgraded plugin
+	if err := ioutil.WriteFile(vmPath, []byte("v2"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if config, err = lc.installPlugin("node1", vmID, vmPath); err != nil || config == "" {
+		t.Fatalf("unexpected config %q, %v", config, err)
+	}
+	if b, err := ioutil.ReadFile(target); err != nil || string(b) != "v2" {
+		t.Fatalf("plugin not upgraded: %q, %v", b, err)
+	}
+}
+
+func TestLinkPlugins(t *testing.T) {
+	lc := newTestNetwork(t, newFakeBinary(t), 1, nil)
+	execPath := filepath.Join(t.TempDir(), "avalanchego")
+	binPlugins := filepath.Join(filepath.Dir(execPath), pluginsDirName)
+	if err := os.MkdirAll(binPlugins, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(binPlugins, "evm"), []byte("evm"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	// no-op without the node build directory
+	if err := lc.linkPlugins("node1", []byte(`{}`), execPath); err != nil {
+		t.Fatal(err)
+	}
+	if dirExists(lc.nodeBuildDir("node1")) {
+		t.Fatal("build directory created")
+	}
+
+	pluginDir := filepath.Join(lc.nodeBuildDir("node1"), pluginsDirName)
+	if err := os.MkdirAll(pluginDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	// copied VM plugin, and a link to the plugins of a previous binary
+	if err := ioutil.WriteFile(filepath.Join(pluginDir, "testvm"), []byte("vm"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(t.TempDir(), "old"), filepath.Join(pluginDir, "old")); err != nil {
+		t.Fatal(err)
+	}
+
+	configFile, err := mergeConfig(map[string]interface{}{buildDirKey: lc.nodeBuildDir("node1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lc.linkPlugins("node1", configFile, execPath); err != nil {
+		t.Fatal(err)
+	}
+	if link, err := os.Readlink(filepath.Join(pluginDir, "evm")); err != nil || link != filepath.Join(binPlugins, "evm") {
+		t.Fatalf("plugin not linked: %q, %v", link, err)
+	}
+	if _, err := os.Lstat(filepath.Join(pluginDir, "old")); !os.IsNotExist(err) {
+		t.Fatalf("stale link not removed: %v", err)
+	}
+	if b, err := ioutil.ReadFile(filepath.Join(pluginDir, "testvm")); err != nil || string(b) != "vm" {
+		t.Fatalf("VM plugin not kept: %q, %v", b, err)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	chainConfigDirKey = "chain-config-dir"
+	chainConfigFile   = "config.json"
+	chainUpgradeFile  = "upgrade.json"
+)
+
+var ErrInvalidChainConfig = errors.New("invalid chain config")
+
+// checkChainConfigs returns ErrInvalidChainConfig if a key is not
+// a chain alias or ID that can be a directory name.
+func checkChainConfigs(configs map[string]*rpcpb.ChainConfig) error {
+	for chain := range configs {
+		if chain == "" || chain == "." || chain == ".." || strings.ContainsAny(chain, `/\`) {
+			return fmt.Errorf("%w: chain %q", ErrInvalidChainConfig, chain)
+		}
+	}
+	return nil
+}
+
+// mergeChainConfigs returns copies of the chain configs in [base],
+// replaced per chain by the ones in [overrides]. Empty overrides remove
+// the chain configs.
+func mergeChainConfigs(base map[string]*rpcpb.ChainConfig, overrides map[string]*rpcpb.ChainConfig) map[string]*rpcpb.ChainConfig {
+	merged := make(map[string]*rpcpb.ChainConfig, len(base)+len(overrides))
+	for chain, cc := range base {
+		merged[chain] = proto.Clone(cc).(*rpcpb.ChainConfig)
+	}
+	for chain, cc := range overrides {
+		if cc.GetConfig() == "" && cc.GetUpgrade() == "" {
+			delete(merged, chain)
+			continue
+		}
+		merged[chain] = proto.Clone(cc).(*rpcpb.ChainConfig)
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
+func chainConfigDir(rootDataDir string, name string) string {
+	return filepath.Join(rootDataDir, name, "chain-configs")
+}
+
+// chainConfigOverride returns the node config that points avalanchego
+// at the chain configs of the node, if any.
+func chainConfigOverride(rootDataDir string, name string, configs map[string]*rpcpb.ChainConfig) string {
+	if len(configs) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`{%q:%q}`, chainConfigDirKey, chainConfigDir(rootDataDir, name))
+}
+
+// writeChainConfigs replaces the files in the chain config directory of
+// the node in [info] with its chain configs, right before launching it.
+// No-op if the node config points at another directory.
+func (lc *localNetwork) writeChainConfigs(info *rpcpb.NodeInfo) error {
+	dir := chainConfigDir(lc.rootDataDir, info.Name)
+	if info.ChainConfigDir != dir {
+		return nil
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	for chain, cc := range info.ChainConfigs {
+		chainDir := filepath.Join(dir, chain)
+		if err := os.MkdirAll(chainDir, 0o750); err != nil {
+			return err
+		}
+		if cc.Config != "" {
+			if err := ioutil.WriteFile(filepath.Join(chainDir, chainConfigFile), []byte(cc.Config), 0o600); err != nil {
+				return err
+			}
+		}
+		if cc.Upgrade != "" {
+			if err := ioutil.WriteFile(filepath.Join(chainDir, chainUpgradeFile), []byte(cc.Upgrade), 0o600); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setChainConfigs merges [configs] over the chain configs of the node,
+// which are written on the next restart.
+func (lc *localNetwork) setChainConfigs(name string, configs map[string]*rpcpb.ChainConfig) error {
+	info, ok := lc.nodeInfos[name]
+	if !ok {
+		return ErrNodeNotFound
+	}
+	if err := checkChainConfigs(configs); err != nil {
+		return err
+	}
+	info.ChainConfigs = mergeChainConfigs(info.ChainConfigs, configs)
+	return nil
+}
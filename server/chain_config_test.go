@@ -0,0 +1,188 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheckChainConfigs(t *testing.T) {
+	if err := checkChainConfigs(map[string]*rpcpb.ChainConfig{"C": {}, "2JVSBoinj9C2J33VntvzYtVJNZdN2NKiwwKjcumHUWEb5DbBrm": {}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, chain := range []string{"", ".", "..", "a/b", `a\b`} {
+		if err := checkChainConfigs(map[string]*rpcpb.ChainConfig{chain: {}}); !errors.Is(err, ErrInvalidChainConfig) {
+			t.Fatalf("%q: expected %v, got %v", chain, ErrInvalidChainConfig, err)
+		}
+	}
+}
+
+func TestMergeChainConfigs(t *testing.T) {
+	base := map[string]*rpcpb.ChainConfig{
+		"C": {Config: `{"pruning-enabled":false}`},
+		"X": {Config: `{"x":1}`},
+	}
+	merged := mergeChainConfigs(base, map[string]*rpcpb.ChainConfig{
+		"C": {Upgrade: `{}`},
+		// removed
+		"X": {},
+		"P": {Config: `{"p":1}`},
+	})
+	expected := map[string]*rpcpb.ChainConfig{
+		"C": {Upgrade: `{}`},
+		"P": {Config: `{"p":1}`},
+	}
+	if len(merged) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, merged)
+	}
+	for chain, cc := range expected {
+		if !proto.Equal(merged[chain], cc) {
+			t.Fatalf("chain %q: expected %v, got %v", chain, cc, merged[chain])
+		}
+	}
+	// the base is copied
+	merged2 := mergeChainConfigs(base, nil)
+	merged2["C"].Config = ""
+	if base["C"].Config == "" {
+		t.Fatal("base modified")
+	}
+	if merged := mergeChainConfigs(nil, map[string]*rpcpb.ChainConfig{"C": {}}); merged != nil {
+		t.Fatalf("expected no chain configs, got %v", merged)
+	}
+}
+
+func TestWriteChainConfigs(t *testing.T) {
+	chainConfigs := map[string]*rpcpb.ChainConfig{
+		"C": {Config: `{"pruning-enabled":false}`, Upgrade: `{"upgrade":1}`},
+		"X": {Config: `{"x":1}`},
+	}
+	lc := newTestNetwork(t, newFakeBinary(t), 1, chainConfigs)
+	info := lc.nodeInfos["node1"]
+	dir := chainConfigDir(lc.rootDataDir, "node1")
+	if info.ChainConfigDir != dir {
+		t.Fatalf("expected chain config dir %q, got %q", dir, info.ChainConfigDir)
+	}
+
+	if err := lc.writeChainConfigs(info); err != nil {
+		t.Fatal(err)
+	}
+	for path, expected := range map[string]string{
+		filepath.Join(dir, "C", chainConfigFile):  `{"pruning-enabled":false}`,
+		filepath.Join(dir, "C", chainUpgradeFile): `{"upgrade":1}`,
+		filepath.Join(dir, "X", chainConfigFile):  `{"x":1}`,
+	} {
+		if b, err := ioutil.ReadFile(path); err != nil || string(b) != expected {
+			t.Fatalf("%q: expected %q, got %q, %v", path, expected, b, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "X", chainUpgradeFile)); !os.IsNotExist(err) {
+		t.Fatalf("unexpected upgrade file: %v", err)
+	}
+
+	// the updated configs replace the files on the next write
+	if err := lc.setChainConfigs("node1", map[string]*rpcpb.ChainConfig{"X": {}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := lc.writeChainConfigs(info); err != nil {
+		t.Fatal(err)
+	}
+	if dirExists(filepath.Join(dir, "X")) {
+		t.Fatal("removed chain config not deleted")
+	}
+	if err := lc.setChainConfigs("node2", nil); err != ErrNodeNotFound {
+		t.Fatalf("expected %v, got %v", ErrNodeNotFound, err)
+	}
+
+	// no-op when the node config points at another directory
+	other := t.TempDir()
+	info.ChainConfigDir = other
+	if err := lc.writeChainConfigs(info); err != nil {
+		t.Fatal(err)
+	}
+	if entries, err := ioutil.ReadDir(other); err != nil || len(entries) != 0 {
+		t.Fatalf("unexpected chain configs in %q: %v, %v", other, entries, err)
+	}
+}
+
+func TestAddAndRestartNodeChainConfigs(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.Start(context.Background(), &rpcpb.StartRequest{
+		ExecPath:      newFakeNode(t),
+		NumberOfNodes: proto.Uint32(1),
+		ChainConfigs:  map[string]*rpcpb.ChainConfig{"C": {Config: `{"pruning-enabled":false}`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := s.Stop(context.Background(), &rpcpb.StopRequest{}); err != nil {
+			t.Error(err)
+		}
+	})
+	waitOperation(t, s, resp.Operation)
+	rootDataDir := resp.ClusterInfo.RootDataDir
+
+	checkFiles := func(name string, expected map[string]string) {
+		t.Helper()
+		dir := chainConfigDir(rootDataDir, name)
+		for chain, config := range expected {
+			path := filepath.Join(dir, chain, chainConfigFile)
+			if b, err := ioutil.ReadFile(path); err != nil || string(b) != config {
+				t.Fatalf("%q: expected %q, got %q, %v", path, config, b, err)
+			}
+		}
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != len(expected) {
+			t.Fatalf("node %q: expected chain configs %v, got %d", name, expected, len(entries))
+		}
+	}
+
+	// the node configs are merged over the cluster configs
+	addResp, err := s.AddNode(context.Background(), &rpcpb.AddNodeRequest{
+		NodeSpec: &rpcpb.NodeSpec{
+			Name:         "node2",
+			ChainConfigs: map[string]*rpcpb.ChainConfig{"X": {Config: `{"x":1}`}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOperation(t, s, addResp.Operation)
+	checkFiles("node2", map[string]string{"C": `{"pruning-enabled":false}`, "X": `{"x":1}`})
+	if ccs := getInfo(t, s).NodeInfos["node2"].ChainConfigs; len(ccs) != 2 {
+		t.Fatalf("unexpected chain configs %v", ccs)
+	}
+
+	// an empty entry removes the chain config on restart
+	restartResp, err := s.RestartNode(context.Background(), &rpcpb.RestartNodeRequest{
+		Name: "node2",
+		ChainConfigs: map[string]*rpcpb.ChainConfig{
+			"C": {},
+			"X": {Config: `{"x":2}`},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOperation(t, s, restartResp.Operation)
+	checkFiles("node2", map[string]string{"X": `{"x":2}`})
+	checkFiles("node1", map[string]string{"C": `{"pruning-enabled":false}`})
+
+	if _, err := s.AddNode(context.Background(), &rpcpb.AddNodeRequest{
+		NodeSpec: &rpcpb.NodeSpec{ChainConfigs: map[string]*rpcpb.ChainConfig{"../C": {}}},
+	}); !errors.Is(err, ErrInvalidChainConfig) {
+		t.Fatalf("expected %v, got %v", ErrInvalidChainConfig, err)
+	}
+}
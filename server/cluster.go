@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultClusterName is used when a request does not specify a cluster.
+const DefaultClusterName = "default"
+
+var ErrInvalidClusterName = errors.New("invalid cluster name")
+
+// cluster and snapshot names are used as part of directory names
+var nameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// cluster is a named local network managed by the server.
+type cluster struct {
+	name string
+
+	// protects "info" and "network"
+	mu      sync.RWMutex
+	info    *rpcpb.ClusterInfo
+	network *localNetwork
+
+	// closed once the network is healthy or failed to start,
+	// with the failure in "startErr"
+	startedc chan struct{}
+	startErr error
+
+	// held while an operation changes the network, so that
+	// operations run one at a time
+	opc chan struct{}
+}
+
+func newCluster(name string) *cluster {
+	return &cluster{
+		name:     name,
+		startedc: make(chan struct{}),
+		opc:      make(chan struct{}, 1),
+	}
+}
+
+// getInfo returns the latest published cluster info,
+// which is not modified afterwards.
+func (c *cluster) getInfo() *rpcpb.ClusterInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.info
+}
+
+// getNetwork returns the network without the operation lock, for the
+// reads that are safe to run along with operations (e.g., node logs).
+func (c *cluster) getNetwork() (*localNetwork, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+	return c.network, nil
+}
+
+// lockStartedNetwork waits for the network to be healthy after start,
+// and acquires the operation lock. The caller must call "unlockNetwork".
+func (c *cluster) lockStartedNetwork(ctx context.Context) (*localNetwork, error) {
+	select {
+	case <-c.startedc:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if c.startErr != nil {
+		return nil, c.startErr
+	}
+	return c.lockNetwork(ctx)
+}
+
+// lockNetwork acquires the operation lock, and returns the network.
+// The caller must call "unlockNetwork".
+func (c *cluster) lockNetwork(ctx context.Context) (*localNetwork, error) {
+	select {
+	case c.opc <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	c.mu.RLock()
+	nw := c.network
+	c.mu.RUnlock()
+	if nw == nil {
+		<-c.opc
+		return nil, ErrNotBootstrapped
+	}
+	return nw, nil
+}
+
+func (c *cluster) unlockNetwork() {
+	<-c.opc
+}
+
+// publish copies the node states of [nw] to a new cluster info, so that
+// status reads do not race with operations. The caller must hold the
+// operation lock, or be the only one that runs [nw].
+func (c *cluster) publish(nw *localNetwork) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.publishLocked(nw)
+}
+
+// publishLocked is "publish" for the caller that holds "c.mu".
+func (c *cluster) publishLocked(nw *localNetwork) {
+	if c.network != nw || c.info == nil {
+		return
+	}
+	info := proto.Clone(c.info).(*rpcpb.ClusterInfo)
+	info.NodeNames = append([]string(nil), nw.nodeNames...)
+	info.NodeInfos = make(map[string]*rpcpb.NodeInfo, len(nw.nodeInfos))
+	for name, nodeInfo := range nw.nodeInfos {
+		info.NodeInfos[name] = proto.Clone(nodeInfo).(*rpcpb.NodeInfo)
+	}
+	info.Healthy = nw.healthy
+	info.SubnetIds = append([]string(nil), nw.subnetIDs...)
+	info.Blockchains = nw.blockchainInfos()
+	nw.monitor.apply(info.NodeInfos)
+	nw.supervisor.apply(info.NodeInfos)
+	c.info = info
+}
+
+// refresh publishes the latest node process and health states of [nw],
+// which the node monitor checks in the background. Safe to call
+// without the operation lock.
+func (c *cluster) refresh(nw *localNetwork) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.network != nw || c.info == nil {
+		return
+	}
+	info := proto.Clone(c.info).(*rpcpb.ClusterInfo)
+	nw.monitor.apply(info.NodeInfos)
+	nw.supervisor.apply(info.NodeInfos)
+	c.info = info
+}
+
+// checkClusterName returns the default cluster name if empty.
+func checkClusterName(name string) (string, error) {
+	if name == "" {
+		return DefaultClusterName, nil
+	}
+	if !nameRegex.MatchString(name) {
+		return "", ErrInvalidClusterName
+	}
+	return name, nil
+}
+
+// getCluster returns the started cluster of the name.
+func (s *server) getCluster(name string) (*cluster, error) {
+	name, err := checkClusterName(name)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	c, ok := s.clusters[name]
+	s.mu.RUnlock()
+	if !ok || c.getInfo() == nil {
+		return nil, ErrNotBootstrapped
+	}
+	return c, nil
+}
+
+func (s *server) deleteCluster(name string) {
+	s.mu.Lock()
+	delete(s.clusters, name)
+	s.mu.Unlock()
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"go.uber.org/zap"
+)
+
+// events kept for watchers to resume from
+const maxEvents = 4096
+
+var ErrCompacted = errors.New("required revision has been compacted")
+
+// events is the log of the cluster lifecycle changes, with a revision
+// that increases by one for each event across all clusters.
+type events struct {
+	mu  sync.Mutex
+	rev uint64
+	// last [maxEvents] events, in revision order
+	buf []*rpcpb.Event
+	// closed and replaced when a new event is published
+	updatec chan struct{}
+}
+
+func newEvents() *events {
+	return &events{updatec: make(chan struct{})}
+}
+
+// publish assigns the next revision to [ev], and notifies the watchers.
+// The event must not be modified afterwards.
+func (es *events) publish(ev *rpcpb.Event) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	es.rev++
+	ev.Revision = es.rev
+	ev.Timestamp = time.Now().UnixNano()
+	es.buf = append(es.buf, ev)
+	if len(es.buf) > maxEvents {
+		es.buf = append([]*rpcpb.Event(nil), es.buf[len(es.buf)-maxEvents:]...)
+	}
+	zap.L().Info("event",
+		zap.Uint64("revision", ev.Revision),
+		zap.String("type", ev.Type.String()),
+		zap.String("cluster", ev.ClusterName),
+		zap.String("node", ev.NodeName),
+	)
+
+	close(es.updatec)
+	es.updatec = make(chan struct{})
+}
+
+// current returns the latest revision.
+func (es *events) current() uint64 {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	return es.rev
+}
+
+// since returns the events after [rev], and a channel closed on the next
+// event. Returns ErrCompacted if some of the events are no longer kept.
+func (es *events) since(rev uint64) ([]*rpcpb.Event, <-chan struct{}, error) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if rev >= es.rev {
+		return nil, es.updatec, nil
+	}
+	first := es.buf[0].Revision
+	if rev+1 < first {
+		return nil, nil, ErrCompacted
+	}
+	evs := append([]*rpcpb.Event(nil), es.buf[rev+1-first:]...)
+	return evs, es.updatec, nil
+}
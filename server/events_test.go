@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEventsRevisions(t *testing.T) {
+	es := newEvents()
+	if es.current() != 0 {
+		t.Fatalf("expected revision 0, got %d", es.current())
+	}
+	evs, updatec, err := es.since(0)
+	if err != nil || len(evs) != 0 {
+		t.Fatalf("unexpected events %v, %v", evs, err)
+	}
+
+	es.publish(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED, NodeName: "node1"})
+	select {
+	case <-updatec:
+	case <-time.After(10 * time.Second):
+		t.Fatal("watchers not notified")
+	}
+	es.publish(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED, NodeName: "node2"})
+	if es.current() != 2 {
+		t.Fatalf("expected revision 2, got %d", es.current())
+	}
+
+	evs, _, err = es.since(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evs) != 2 || evs[0].Revision != 1 || evs[1].Revision != 2 || evs[1].NodeName != "node2" {
+		t.Fatalf("unexpected events %v", evs)
+	}
+	if evs[0].Timestamp == 0 {
+		t.Fatal("event not timestamped")
+	}
+	evs, _, err = es.since(1)
+	if err != nil || len(evs) != 1 || evs[0].Revision != 2 {
+		t.Fatalf("unexpected events %v, %v", evs, err)
+	}
+	if evs, _, err = es.since(2); err != nil || len(evs) != 0 {
+		t.Fatalf("unexpected events %v, %v", evs, err)
+	}
+}
+
+func TestEventsCompacted(t *testing.T) {
+	es := newEvents()
+	for i := 0; i < maxEvents+10; i++ {
+		es.publish(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED})
+	}
+	if len(es.buf) != maxEvents {
+		t.Fatalf("expected %d events, got %d", maxEvents, len(es.buf))
+	}
+	if _, _, err := es.since(0); err != ErrCompacted {
+		t.Fatalf("expected %v, got %v", ErrCompacted, err)
+	}
+	if _, _, err := es.since(9); err != ErrCompacted {
+		t.Fatalf("expected %v, got %v", ErrCompacted, err)
+	}
+	// the oldest event kept
+	evs, _, err := es.since(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evs) != maxEvents || evs[0].Revision != 11 {
+		t.Fatalf("unexpected events from revision %d", evs[0].Revision)
+	}
+}
+
+// testEventStream collects the events sent to a WatchEvents client.
+type testEventStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	evc chan *rpcpb.Event
+}
+
+func (s *testEventStream) Context() context.Context { return s.ctx }
+
+func (s *testEventStream) Send(resp *rpcpb.WatchEventsResponse) error {
+	s.evc <- resp.Event
+	return nil
+}
+
+func TestWatchEvents(t *testing.T) {
+	s := newTestServer(t)
+	s.events.publish(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_CLUSTER_STARTING, ClusterName: "other"})
+	if _, err := s.Start(context.Background(), &rpcpb.StartRequest{ExecPath: newFakeBinary(t), NumberOfNodes: proto.Uint32(1)}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		// no-op once stopped
+		s.Stop(context.Background(), &rpcpb.StopRequest{})
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testEventStream{ctx: ctx, evc: make(chan *rpcpb.Event, 10)}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.WatchEvents(&rpcpb.WatchEventsRequest{ClusterName: DefaultClusterName, StartRevision: 1}, stream)
+	}()
+	receive := func(typ rpcpb.EventType, nodeName string) {
+		select {
+		case ev := <-stream.evc:
+			if ev.Type != typ || ev.ClusterName != DefaultClusterName || ev.NodeName != nodeName {
+				t.Fatalf("expected %v of %q, got %+v", typ, nodeName, ev)
+			}
+		case err := <-errc:
+			t.Fatalf("WatchEvents returned %v", err)
+		case <-time.After(30 * time.Second):
+			t.Fatalf("%v of %q not sent", typ, nodeName)
+		}
+	}
+	// the past events of the cluster are replayed
+	receive(rpcpb.EventType_EVENT_TYPE_CLUSTER_STARTING, "")
+	receive(rpcpb.EventType_EVENT_TYPE_NODE_STARTED, "node1")
+	if _, err := s.Stop(context.Background(), &rpcpb.StopRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	receive(rpcpb.EventType_EVENT_TYPE_CLUSTER_STOPPED, "")
+
+	// the stream ends without an error once the client is done
+	cancel()
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("WatchEvents did not return")
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"go.uber.org/zap"
+)
+
+// Cutting or shaping the traffic between nodes needs a proxy in front of
+// each staking port, but avalanchego v1.7.2 advertises the port it listens
+// on (there is no flag to advertise another one), so the peers learn each
+// other's real staking ports through gossip and dial them directly.
+var (
+	ErrPartitionUnsupported      = errors.New("network partitions not supported: avalanchego v1.7.2 advertises its listen staking port, and has no flag to advertise a proxy port")
+	ErrLinkConditionsUnsupported = errors.New("link conditions not supported: avalanchego v1.7.2 advertises its listen staking port, and has no flag to advertise a proxy port")
+)
+
+func (s *server) Partition(ctx context.Context, req *rpcpb.PartitionRequest) (*rpcpb.PartitionResponse, error) {
+	zap.L().Info("received partition request", zap.String("cluster", req.GetClusterName()))
+	return nil, ErrPartitionUnsupported
+}
+
+func (s *server) HealPartition(ctx context.Context, req *rpcpb.HealPartitionRequest) (*rpcpb.HealPartitionResponse, error) {
+	zap.L().Info("received heal partition request", zap.String("cluster", req.GetClusterName()))
+	return nil, ErrPartitionUnsupported
+}
+
+func (s *server) SetLinkConditions(ctx context.Context, req *rpcpb.SetLinkConditionsRequest) (*rpcpb.SetLinkConditionsResponse, error) {
+	zap.L().Info("received set link conditions request",
+		zap.String("cluster", req.GetClusterName()),
+		zap.String("from", req.GetFrom()),
+		zap.String("to", req.GetTo()),
+	)
+	return nil, ErrLinkConditionsUnsupported
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPartitionUnimplemented(t *testing.T) {
+	s := newTestServer(t)
+	_, err := s.Partition(context.Background(), &rpcpb.PartitionRequest{
+		Groups: []*rpcpb.NodeGroup{{NodeNames: []string{"node1"}}, {NodeNames: []string{"node2"}}},
+	})
+	if code := status.Code(toStatusError(err)); code != codes.Unimplemented {
+		t.Fatalf("expected %v, got %v (%v)", codes.Unimplemented, code, err)
+	}
+	_, err = s.HealPartition(context.Background(), &rpcpb.HealPartitionRequest{})
+	if code := status.Code(toStatusError(err)); code != codes.Unimplemented {
+		t.Fatalf("expected %v, got %v (%v)", codes.Unimplemented, code, err)
+	}
+}
+
+func TestSetLinkConditionsUnimplemented(t *testing.T) {
+	s := newTestServer(t)
+	_, err := s.SetLinkConditions(context.Background(), &rpcpb.SetLinkConditionsRequest{From: "node1", To: "node2", LatencyMs: 100})
+	if code := status.Code(toStatusError(err)); code != codes.Unimplemented {
+		t.Fatalf("expected %v, got %v (%v)", codes.Unimplemented, code, err)
+	}
+}
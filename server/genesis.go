@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	avagenesis "github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/gyuho/avax-tester/rpcpb"
+)
+
+var (
+	ErrNoStakers      = errors.New("no initial stakers in genesis")
+	ErrInvalidGenesis = errors.New("invalid genesis")
+	ErrUnknownStaker  = errors.New("genesis staker is not a node in the cluster")
+)
+
+// newGenesis generates the genesis from the default [genesis]:
+// every node in [nodeConfigs] (and only those) is a validator, and
+// the optional [params] add allocations and override the staking
+// durations and the C-chain genesis.
+func newGenesis(genesis []byte, nodeConfigs []node.Config, params *rpcpb.GenesisParams) ([]byte, error) {
+	var gcfg avagenesis.UnparsedConfig
+	if err := json.Unmarshal(genesis, &gcfg); err != nil {
+		return nil, err
+	}
+	if len(gcfg.InitialStakers) == 0 {
+		return nil, ErrNoStakers
+	}
+	template := gcfg.InitialStakers[0]
+
+	stakers := make(map[string]avagenesis.UnparsedStaker)
+	for _, staker := range gcfg.InitialStakers {
+		stakers[staker.NodeID] = staker
+	}
+	gcfg.InitialStakers = make([]avagenesis.UnparsedStaker, 0, len(nodeConfigs))
+	for _, cfg := range nodeConfigs {
+		nodeID, err := utils.ToNodeID(cfg.StakingKey, cfg.StakingCert)
+		if err != nil {
+			return nil, err
+		}
+		id := nodeID.PrefixedString(constants.NodeIDPrefix)
+		staker, ok := stakers[id]
+		if !ok {
+			staker = template
+			staker.NodeID = id
+		}
+		gcfg.InitialStakers = append(gcfg.InitialStakers, staker)
+	}
+
+	if params != nil {
+		// keep the default allocations, since the pre-funded key
+		// is used to issue P-chain transactions
+		for _, alloc := range params.Allocations {
+			ua := avagenesis.UnparsedAllocation{
+				ETHAddr:       alloc.EthAddr,
+				AVAXAddr:      alloc.AvaxAddr,
+				InitialAmount: alloc.InitialAmount,
+			}
+			if ua.ETHAddr == "" {
+				ua.ETHAddr = "0x0000000000000000000000000000000000000000"
+			}
+			for _, locked := range alloc.UnlockSchedule {
+				ua.UnlockSchedule = append(ua.UnlockSchedule, avagenesis.LockedAmount{
+					Amount:   locked.Amount,
+					Locktime: locked.Locktime,
+				})
+			}
+			gcfg.Allocations = append(gcfg.Allocations, ua)
+		}
+		if params.InitialStakeDuration > 0 {
+			gcfg.InitialStakeDuration = params.InitialStakeDuration
+		}
+		if params.InitialStakeDurationOffset > 0 {
+			gcfg.InitialStakeDurationOffset = params.InitialStakeDurationOffset
+		}
+		if params.CChainGenesis != "" {
+			gcfg.CChainGenesis = params.CChainGenesis
+		}
+	}
+
+	b, err := json.Marshal(gcfg)
+	if err != nil {
+		return nil, err
+	}
+	if params != nil {
+		// parsed as the base64-encoded "--genesis-content" flag
+		if _, _, err := avagenesis.FromFlag(gcfg.NetworkID, base64.StdEncoding.EncodeToString(b)); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidGenesis, err)
+		}
+	}
+	return b, nil
+}
+
+// validateGenesis returns an error if the custom [genesis] is invalid,
+// or if any of its initial stakers is not one of [nodeConfigs].
+func validateGenesis(genesis []byte, nodeConfigs []node.Config) error {
+	networkID, err := utils.NetworkIDFromGenesis(genesis)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidGenesis, err)
+	}
+	if _, _, err := avagenesis.FromFlag(networkID, base64.StdEncoding.EncodeToString(genesis)); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidGenesis, err)
+	}
+
+	var gcfg avagenesis.UnparsedConfig
+	if err := json.Unmarshal(genesis, &gcfg); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidGenesis, err)
+	}
+	nodeIDs := make(map[string]struct{}, len(nodeConfigs))
+	for _, cfg := range nodeConfigs {
+		nodeID, err := utils.ToNodeID(cfg.StakingKey, cfg.StakingCert)
+		if err != nil {
+			return err
+		}
+		nodeIDs[nodeID.PrefixedString(constants.NodeIDPrefix)] = struct{}{}
+	}
+	for _, staker := range gcfg.InitialStakers {
+		if _, ok := nodeIDs[staker.NodeID]; !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownStaker, staker.NodeID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	avagenesis "github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/gyuho/avax-tester/rpcpb"
+)
+
+func parseGenesis(t *testing.T, b []byte) avagenesis.UnparsedConfig {
+	t.Helper()
+	var gcfg avagenesis.UnparsedConfig
+	if err := json.Unmarshal(b, &gcfg); err != nil {
+		t.Fatal(err)
+	}
+	return gcfg
+}
+
+func checkStakers(t *testing.T, gcfg avagenesis.UnparsedConfig, nodeConfigs []node.Config) {
+	t.Helper()
+	if len(gcfg.InitialStakers) != len(nodeConfigs) {
+		t.Fatalf("expected %d stakers, got %d", len(nodeConfigs), len(gcfg.InitialStakers))
+	}
+	for i, cfg := range nodeConfigs {
+		nodeID, err := utils.ToNodeID(cfg.StakingKey, cfg.StakingCert)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id := nodeID.PrefixedString(constants.NodeIDPrefix); gcfg.InitialStakers[i].NodeID != id {
+			t.Fatalf("staker %d: expected %q, got %q", i, id, gcfg.InitialStakers[i].NodeID)
+		}
+	}
+}
+
+func TestNewGenesisStakers(t *testing.T) {
+	cfg := local.NewDefaultConfig("avalanchego")
+	defaults := parseGenesis(t, cfg.Genesis)
+
+	for _, n := range []int{1, len(cfg.NodeConfigs), len(cfg.NodeConfigs) + 2} {
+		nodeConfigs, err := newNodeConfigs(cfg.NodeConfigs, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := newGenesis(cfg.Genesis, nodeConfigs, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gcfg := parseGenesis(t, b)
+		checkStakers(t, gcfg, nodeConfigs)
+		if len(gcfg.Allocations) != len(defaults.Allocations) {
+			t.Fatalf("expected the default allocations, got %v", gcfg.Allocations)
+		}
+		if err := validateGenesis(b, nodeConfigs); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestNewGenesisParams(t *testing.T) {
+	cfg := local.NewDefaultConfig("avalanchego")
+	defaults := parseGenesis(t, cfg.Genesis)
+	params := &rpcpb.GenesisParams{
+		Allocations: []*rpcpb.GenesisAllocation{{
+			AvaxAddr:      defaults.Allocations[0].AVAXAddr,
+			InitialAmount: 1000,
+		}},
+		InitialStakeDuration: defaults.InitialStakeDuration * 2,
+	}
+	b, err := newGenesis(cfg.Genesis, cfg.NodeConfigs, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcfg := parseGenesis(t, b)
+	if len(gcfg.Allocations) != len(defaults.Allocations)+1 {
+		t.Fatalf("expected %d allocations, got %d", len(defaults.Allocations)+1, len(gcfg.Allocations))
+	}
+	added := gcfg.Allocations[len(gcfg.Allocations)-1]
+	if added.InitialAmount != 1000 || added.ETHAddr == "" {
+		t.Fatalf("unexpected allocation %+v", added)
+	}
+	if gcfg.InitialStakeDuration != defaults.InitialStakeDuration*2 {
+		t.Fatalf("stake duration not overridden: %d", gcfg.InitialStakeDuration)
+	}
+
+	params.Allocations[0].AvaxAddr = "invalid"
+	if _, err := newGenesis(cfg.Genesis, cfg.NodeConfigs, params); !errors.Is(err, ErrInvalidGenesis) {
+		t.Fatalf("expected %v, got %v", ErrInvalidGenesis, err)
+	}
+	if _, err := newGenesis([]byte(`{"networkID":1337}`), cfg.NodeConfigs, nil); !errors.Is(err, ErrNoStakers) {
+		t.Fatalf("expected %v, got %v", ErrNoStakers, err)
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	cfg := local.NewDefaultConfig("avalanchego")
+	if err := validateGenesis(cfg.Genesis, cfg.NodeConfigs); err != nil {
+		t.Fatal(err)
+	}
+	// a staker of the genesis is not in the cluster
+	if err := validateGenesis(cfg.Genesis, cfg.NodeConfigs[1:]); !errors.Is(err, ErrUnknownStaker) {
+		t.Fatalf("expected %v, got %v", ErrUnknownStaker, err)
+	}
+	if err := validateGenesis([]byte(`{`), cfg.NodeConfigs); !errors.Is(err, ErrInvalidGenesis) {
+		t.Fatalf("expected %v, got %v", ErrInvalidGenesis, err)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	logStreamStdout = "stdout"
+	logStreamStderr = "stderr"
+
+	// lines kept per node output stream
+	maxLogLines = 10000
+
+	logFilePollInterval = 500 * time.Millisecond
+)
+
+var (
+	ErrInvalidLogStream = errors.New("invalid log stream")
+	ErrLogNotFound      = errors.New("log not found")
+)
+
+// logBuffer keeps the last lines written by a node process.
+type logBuffer struct {
+	mu sync.Mutex
+	// last [maxLogLines] lines
+	lines []string
+	// number of lines ever written
+	total uint64
+	// incomplete last line
+	partial []byte
+	// closed and replaced when new lines are written
+	updatec chan struct{}
+}
+
+func newLogBuffer() *logBuffer {
+	return &logBuffer{updatec: make(chan struct{})}
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.partial = append(b.partial, p...)
+	written := false
+	for {
+		idx := bytes.IndexByte(b.partial, '\n')
+		if idx < 0 {
+			break
+		}
+		b.lines = append(b.lines, string(b.partial[:idx]))
+		b.partial = b.partial[idx+1:]
+		b.total++
+		written = true
+	}
+	if len(b.lines) > maxLogLines {
+		b.lines = append([]string(nil), b.lines[len(b.lines)-maxLogLines:]...)
+	}
+	if len(b.partial) == 0 {
+		b.partial = nil
+	}
+	if written {
+		close(b.updatec)
+		b.updatec = make(chan struct{})
+	}
+	return len(p), nil
+}
+
+// start returns the index of the first of the last [tail] lines
+// (the oldest buffered line, if zero).
+func (b *logBuffer) start(tail uint32) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	first := b.total - uint64(len(b.lines))
+	if tail == 0 || uint64(tail) >= uint64(len(b.lines)) {
+		return first
+	}
+	return b.total - uint64(tail)
+}
+
+// since returns the buffered lines from the [next]th line, the index
+// of the line after them, and a channel closed on new lines. Lines no
+// longer buffered are skipped.
+func (b *logBuffer) since(next uint64) ([]string, uint64, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	first := b.total - uint64(len(b.lines))
+	if next < first {
+		next = first
+	}
+	lines := append([]string(nil), b.lines[next-first:]...)
+	return lines, b.total, b.updatec
+}
+
+// nodeLog is the output of a node process.
+type nodeLog struct {
+	stdout *logBuffer
+	stderr *logBuffer
+}
+
+// nodeLogs keeps the output of the nodes in a network, including the
+// removed ones, so that remote clients can read it.
+type nodeLogs struct {
+	mu   sync.RWMutex
+	logs map[string]*nodeLog
+}
+
+func newNodeLogs() *nodeLogs {
+	return &nodeLogs{logs: make(map[string]*nodeLog)}
+}
+
+// writers returns the stdout and stderr writers of the node, which print
+// to the server stdout in color [c] and keep the lines for "StreamLogs".
+func (nl *nodeLogs) writers(name string, c string) (stdout *writer, stderr *writer) {
+	nl.mu.Lock()
+	l, ok := nl.logs[name]
+	if !ok {
+		// keep the output of a removed node with the same name
+		l = &nodeLog{stdout: newLogBuffer(), stderr: newLogBuffer()}
+		nl.logs[name] = l
+	}
+	nl.mu.Unlock()
+
+	stdout = &writer{c: c, name: name, w: os.Stdout, buf: l.stdout}
+	stderr = &writer{c: c, name: name, w: os.Stdout, buf: l.stderr}
+	return stdout, stderr
+}
+
+func (nl *nodeLogs) get(name string, stream string) (*logBuffer, error) {
+	nl.mu.RLock()
+	l, ok := nl.logs[name]
+	nl.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrNodeNotFound, name)
+	}
+	if stream == logStreamStderr {
+		return l.stderr, nil
+	}
+	return l.stdout, nil
+}
+
+// logFilePath returns the path of the log file [stream] in [logDir],
+// which must not point outside the directory.
+func logFilePath(logDir string, stream string) (string, error) {
+	p := filepath.Clean(stream)
+	if filepath.IsAbs(p) || p == "." || p == ".." || strings.HasPrefix(p, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidLogStream, stream)
+	}
+	return filepath.Join(logDir, p), nil
+}
+
+// streamLogBuffer sends the last [tail] lines of [b] to [send], and
+// then the new lines until [ctx] is done, if [follow].
+func streamLogBuffer(ctx context.Context, b *logBuffer, tail uint32, follow bool, send func(line string) error) error {
+	next := b.start(tail)
+	for {
+		lines, total, updatec := b.since(next)
+		for _, line := range lines {
+			if err := send(line); err != nil {
+				return err
+			}
+		}
+		next = total
+		if !follow {
+			return nil
+		}
+		select {
+		case <-updatec:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// streamLogFile sends the last [tail] lines of the file to [send], and
+// then polls the new lines until [ctx] is done, if [follow]. The file is
+// read again from the start when it shrinks (e.g., rotated).
+func streamLogFile(ctx context.Context, path string, tail uint32, follow bool, send func(line string) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%w: %q", ErrLogNotFound, path)
+		}
+		return err
+	}
+	defer func() {
+		f.Close()
+	}()
+
+	var (
+		r       = bufio.NewReader(f)
+		offset  int64
+		pending string
+	)
+	// reads the complete lines up to the end of the file
+	readLines := func(fn func(line string) error) error {
+		for {
+			s, err := r.ReadString('\n')
+			offset += int64(len(s))
+			pending += s
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+			line := strings.TrimSuffix(pending, "\n")
+			pending = ""
+			if err := fn(line); err != nil {
+				return err
+			}
+		}
+	}
+
+	if tail == 0 {
+		err = readLines(send)
+	} else {
+		last := make([]string, 0, tail)
+		err = readLines(func(line string) error {
+			if len(last) == int(tail) {
+				last = last[1:]
+			}
+			last = append(last, line)
+			return nil
+		})
+		for _, line := range last {
+			if err != nil {
+				break
+			}
+			err = send(line)
+		}
+	}
+	if err != nil || !follow {
+		return err
+	}
+
+	tc := time.NewTicker(logFilePollInterval)
+	defer tc.Stop()
+	for {
+		select {
+		case <-tc.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		fi, err := os.Stat(path)
+		if err != nil {
+			// being rotated
+			continue
+		}
+		if fi.Size() < offset {
+			nf, err := os.Open(path)
+			if err != nil {
+				continue
+			}
+			f.Close()
+			f, offset, pending = nf, 0, ""
+			r.Reset(f)
+		}
+		if err := readLines(send); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLogBuffer(t *testing.T) {
+	b := newLogBuffer()
+	fmt.Fprint(b, "line 0\nline")
+	fmt.Fprint(b, " 1\npartial")
+	lines, next, _ := b.since(0)
+	if !reflect.DeepEqual(lines, []string{"line 0", "line 1"}) || next != 2 {
+		t.Fatalf("unexpected lines %q, next %d", lines, next)
+	}
+	if start := b.start(1); start != 1 {
+		t.Fatalf("expected start 1, got %d", start)
+	}
+	if start := b.start(0); start != 0 {
+		t.Fatalf("expected start 0, got %d", start)
+	}
+
+	// only the last lines are kept
+	for i := 2; i < maxLogLines+5; i++ {
+		fmt.Fprintf(b, "line %d\n", i)
+	}
+	if len(b.lines) != maxLogLines {
+		t.Fatalf("expected %d lines, got %d", maxLogLines, len(b.lines))
+	}
+	if b.lines[0] != "line 5" {
+		t.Fatalf("unexpected first line %q", b.lines[0])
+	}
+	// lines no longer buffered are skipped
+	lines, _, _ = b.since(0)
+	if len(lines) != maxLogLines {
+		t.Fatalf("expected %d lines, got %d", maxLogLines, len(lines))
+	}
+	if start := b.start(0); start != 5 {
+		t.Fatalf("expected start 5, got %d", start)
+	}
+}
+
+func TestStreamLogBuffer(t *testing.T) {
+	b := newLogBuffer()
+	for i := 0; i < 5; i++ {
+		fmt.Fprintf(b, "line %d\n", i)
+	}
+	var lines []string
+	send := func(line string) error {
+		lines = append(lines, line)
+		return nil
+	}
+	if err := streamLogBuffer(context.Background(), b, 2, false, send); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(lines, []string{"line 3", "line 4"}) {
+		t.Fatalf("unexpected lines %q", lines)
+	}
+
+	// new lines are sent when following
+	linec := make(chan string, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errc := make(chan error, 1)
+	go func() {
+		errc <- streamLogBuffer(ctx, b, 1, true, func(line string) error {
+			linec <- line
+			return nil
+		})
+	}()
+	receive := func(expected string) {
+		select {
+		case line := <-linec:
+			if line != expected {
+				t.Fatalf("expected %q, got %q", expected, line)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("%q not sent", expected)
+		}
+	}
+	receive("line 4")
+	fmt.Fprintln(b, "line 5")
+	receive("line 5")
+	cancel()
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestStreamLogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "main.log")
+	if err := ioutil.WriteFile(path, []byte("line 0\nline 1\nline 2\npartial"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	if err := streamLogFile(context.Background(), path, 2, false, func(line string) error {
+		lines = append(lines, line)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(lines, []string{"line 1", "line 2"}) {
+		t.Fatalf("unexpected lines %q", lines)
+	}
+
+	err := streamLogFile(context.Background(), path+".missing", 0, false, func(string) error { return nil })
+	if !errors.Is(err, ErrLogNotFound) {
+		t.Fatalf("expected %v, got %v", ErrLogNotFound, err)
+	}
+}
+
+func TestLogFilePath(t *testing.T) {
+	logDir := "/tmp/node1/log"
+	for _, stream := range []string{"main.log", "C.log", "http/access.log"} {
+		p, err := logFilePath(logDir, stream)
+		if err != nil {
+			t.Fatalf("%q: unexpected error %v", stream, err)
+		}
+		if p != filepath.Join(logDir, stream) {
+			t.Fatalf("%q: unexpected path %q", stream, p)
+		}
+	}
+	for _, stream := range []string{"", ".", "..", "../db-dir/data", "/etc/passwd"} {
+		if _, err := logFilePath(logDir, stream); !errors.Is(err, ErrInvalidLogStream) {
+			t.Fatalf("%q: expected %v, got %v", stream, ErrInvalidLogStream, err)
+		}
+	}
+}
+
+// testLogStream collects the lines sent to a StreamLogs client.
+type testLogStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	linec chan *rpcpb.StreamLogsResponse
+}
+
+func (s *testLogStream) Context() context.Context { return s.ctx }
+
+func (s *testLogStream) Send(resp *rpcpb.StreamLogsResponse) error {
+	s.linec <- resp
+	return nil
+}
+
+func TestStreamLogsFollow(t *testing.T) {
+	// the node prints a line, and another once the test creates [next]
+	dir := t.TempDir()
+	next := filepath.Join(dir, "next")
+	execPath := filepath.Join(dir, "avalanchego")
+	script := fmt.Sprintf("#!/bin/sh\necho line 0\nwhile [ ! -f %q ]; do sleep 0.1; done\necho line 1\nexec sleep 300\n", next)
+	if err := ioutil.WriteFile(execPath, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	s := newTestServer(t)
+	if _, err := s.Start(context.Background(), &rpcpb.StartRequest{ExecPath: execPath, NumberOfNodes: proto.Uint32(1)}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := s.Stop(context.Background(), &rpcpb.StopRequest{}); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testLogStream{ctx: ctx, linec: make(chan *rpcpb.StreamLogsResponse, 10)}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.StreamLogs(&rpcpb.StreamLogsRequest{Follow: true}, stream)
+	}()
+	receive := func(expected string) {
+		select {
+		case resp := <-stream.linec:
+			if resp.NodeName != "node1" || resp.Stream != logStreamStdout || resp.Line != expected {
+				t.Fatalf("expected %q from node1, got %+v", expected, resp)
+			}
+		case err := <-errc:
+			t.Fatalf("StreamLogs returned %v", err)
+		case <-time.After(30 * time.Second):
+			t.Fatalf("%q not sent", expected)
+		}
+	}
+	receive("line 0")
+	if err := ioutil.WriteFile(next, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	receive("line 1")
+
+	// the stream ends without an error once the client is done
+	cancel()
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("StreamLogs did not return")
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const metricsNamespace = "network_runner"
+
+// metrics are the server metrics, served at "/metrics".
+type metrics struct {
+	registry *prometheus.Registry
+
+	rpcRequests   *prometheus.CounterVec
+	rpcDuration   *prometheus.HistogramVec
+	timeToHealthy *prometheus.HistogramVec
+}
+
+func newMetrics(s *server) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpc_requests_total",
+			Help:      "Number of RPC requests by method and status code.",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "RPC latencies by method.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+		}, []string{"method"}),
+		timeToHealthy: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "time_to_healthy_seconds",
+			Help:      "Time for the cluster to be healthy after the operation.",
+			Buckets:   []float64{5, 10, 20, 30, 45, 60, 90, 120, 180, 300},
+		}, []string{"operation"}),
+	}
+	m.registry.MustRegister(
+		m.rpcRequests,
+		m.rpcDuration,
+		m.timeToHealthy,
+		&nodesCollector{s: s},
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// observeHealthy records the time to healthy of the operation
+// started at [start], unless it failed.
+func (m *metrics) observeHealthy(typ string, start time.Time, err error) {
+	if err != nil {
+		return
+	}
+	m.timeToHealthy.WithLabelValues(typ).Observe(time.Since(start).Seconds())
+}
+
+func (m *metrics) observeRPC(method string, start time.Time, err error) {
+	m.rpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// unaryInterceptor records the RPCs, with the status codes converted
+// by the inner interceptor.
+func (m *metrics) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	m.observeRPC(info.FullMethod, start, err)
+	return resp, err
+}
+
+func (m *metrics) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observeRPC(info.FullMethod, start, err)
+	return err
+}
+
+var (
+	nodesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "nodes"),
+		"Number of nodes in the cluster.",
+		[]string{"cluster"}, nil,
+	)
+	healthyNodesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "healthy_nodes"),
+		"Number of nodes that passed the last health check.",
+		[]string{"cluster"}, nil,
+	)
+	unhealthyNodesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "unhealthy_nodes"),
+		"Number of nodes that failed or have not passed a health check yet.",
+		[]string{"cluster"}, nil,
+	)
+	nodeRestartsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "node_restarts_total"),
+		"Number of node restarts, including upgrades and automatic restarts.",
+		[]string{"cluster", "node"}, nil,
+	)
+	nodeCrashesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "node_crashes_total"),
+		"Number of unexpected node process exits.",
+		[]string{"cluster", "node"}, nil,
+	)
+)
+
+// nodesCollector reports the node states from the published
+// cluster infos on each scrape.
+type nodesCollector struct {
+	s *server
+}
+
+func (nc *nodesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- nodesDesc
+	ch <- healthyNodesDesc
+	ch <- unhealthyNodesDesc
+	ch <- nodeRestartsDesc
+	ch <- nodeCrashesDesc
+}
+
+func (nc *nodesCollector) Collect(ch chan<- prometheus.Metric) {
+	nc.s.mu.RLock()
+	clusters := make([]*cluster, 0, len(nc.s.clusters))
+	for _, c := range nc.s.clusters {
+		clusters = append(clusters, c)
+	}
+	nc.s.mu.RUnlock()
+
+	for _, c := range clusters {
+		info := c.getInfo()
+		if info == nil {
+			// still starting
+			continue
+		}
+		healthy := 0
+		for name, nodeInfo := range info.NodeInfos {
+			if nodeInfo.Healthy {
+				healthy++
+			}
+			ch <- prometheus.MustNewConstMetric(nodeRestartsDesc, prometheus.CounterValue, float64(nodeInfo.RestartCount), c.name, name)
+			ch <- prometheus.MustNewConstMetric(nodeCrashesDesc, prometheus.CounterValue, float64(nodeInfo.CrashCount), c.name, name)
+		}
+		ch <- prometheus.MustNewConstMetric(nodesDesc, prometheus.GaugeValue, float64(len(info.NodeInfos)), c.name)
+		ch <- prometheus.MustNewConstMetric(healthyNodesDesc, prometheus.GaugeValue, float64(healthy), c.name)
+		ch <- prometheus.MustNewConstMetric(unhealthyNodesDesc, prometheus.GaugeValue, float64(len(info.NodeInfos)-healthy), c.name)
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/api/health"
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const nodeMonitorInterval = 5 * time.Second
+
+type healthState int
+
+const (
+	healthUnknown healthState = iota
+	healthHealthy
+	healthUnhealthy
+)
+
+type monitoredNode struct {
+	dbDir  string
+	health health.Client
+
+	// zero until the process is found
+	pid      int
+	paused   bool
+	exited   bool
+	exitCode int32
+
+	state       healthState
+	checks      map[string]*rpcpb.HealthCheck
+	healthErr   string
+	lastChecked int64
+}
+
+// nodeMonitor polls the processes and health of the nodes, and emits
+// the events on changes. The nodes are tracked and untracked by the
+// operations, so that stopping a node is not reported as an exit.
+type nodeMonitor struct {
+	emit func(*rpcpb.Event)
+	// called after each check without the lock, to publish the node
+	// states; set before "run"
+	onUpdate func()
+	// called on the process exit, and when the node becomes healthy
+	onExit    func(name string, exitCode int32)
+	onHealthy func(name string)
+
+	// protects the fields below, and the tracked nodes
+	mu     sync.Mutex
+	closed bool
+	nodes  map[string]*monitoredNode
+
+	stopc     chan struct{}
+	closeOnce sync.Once
+}
+
+func newNodeMonitor(emit func(*rpcpb.Event), onExit func(string, int32), onHealthy func(string)) *nodeMonitor {
+	return &nodeMonitor{
+		emit:      emit,
+		onExit:    onExit,
+		onHealthy: onHealthy,
+		nodes:     make(map[string]*monitoredNode),
+		stopc:     make(chan struct{}),
+	}
+}
+
+// track starts monitoring the node process, which runs with [dbDir].
+func (m *nodeMonitor) track(name string, dbDir string, healthCli health.Client) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodes[name] = &monitoredNode{dbDir: dbDir, health: healthCli}
+}
+
+func (m *nodeMonitor) untrack(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.nodes, name)
+}
+
+// markExited tracks the node as exited with an unknown exit code,
+// when its process is gone without a replacement running.
+func (m *nodeMonitor) markExited(name string, dbDir string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodes[name] = &monitoredNode{dbDir: dbDir, exited: true, exitCode: -1, state: healthUnhealthy}
+}
+
+// exited returns true if the process of the tracked node exited.
+func (m *nodeMonitor) exited(name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.nodes[name]
+	return ok && n.exited
+}
+
+// setPaused skips the health checks of the paused node.
+func (m *nodeMonitor) setPaused(name string, paused bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n, ok := m.nodes[name]; ok {
+		n.paused = paused
+	}
+}
+
+func (m *nodeMonitor) run() {
+	tc := time.NewTicker(nodeMonitorInterval)
+	defer tc.Stop()
+	for {
+		select {
+		case <-m.stopc:
+			return
+		case <-tc.C:
+		}
+		m.check()
+	}
+}
+
+// close stops monitoring, so that stopping the nodes afterwards
+// emits no event.
+func (m *nodeMonitor) close() {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+	m.closeOnce.Do(func() {
+		close(m.stopc)
+	})
+}
+
+type nodeCheck struct {
+	name string
+	node *monitoredNode
+
+	pid       int
+	paused    bool
+	exited    bool
+	exitCode  int32
+	state     healthState
+	checked   bool
+	checks    map[string]*rpcpb.HealthCheck
+	healthErr string
+	msg       string
+}
+
+func (m *nodeMonitor) check() {
+	m.mu.Lock()
+	checks := make([]*nodeCheck, 0, len(m.nodes))
+	for name, n := range m.nodes {
+		if n.exited {
+			continue
+		}
+		checks = append(checks, &nodeCheck{name: name, node: n, pid: n.pid, paused: n.paused, state: n.state})
+	}
+	m.mu.Unlock()
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].name < checks[j].name
+	})
+
+	// check without the lock, since health requests may block
+	for _, ck := range checks {
+		if ck.pid == 0 {
+			pid, err := nodePID(ck.node.dbDir)
+			if err != nil {
+				// not started yet
+				continue
+			}
+			ck.pid = pid
+		}
+		exited, exitCode, err := processExited(ck.pid)
+		if err != nil {
+			continue
+		}
+		if exited {
+			ck.exited, ck.exitCode = true, exitCode
+			continue
+		}
+		if ck.paused {
+			continue
+		}
+		reply, err := ck.node.health.Health()
+		ck.checked = true
+		switch {
+		case err != nil:
+			ck.state, ck.healthErr, ck.msg = healthUnhealthy, err.Error(), err.Error()
+		case !reply.Healthy:
+			ck.state, ck.msg = healthUnhealthy, failingChecks(reply)
+		default:
+			ck.state, ck.msg = healthHealthy, ""
+		}
+		if reply != nil {
+			ck.checks = toHealthChecks(reply)
+		}
+	}
+
+	m.update(checks)
+	if m.onUpdate != nil {
+		m.onUpdate()
+	}
+}
+
+// update applies the check results, and emits the events on changes.
+func (m *nodeMonitor) update(checks []*nodeCheck) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return
+	}
+	now := time.Now().UnixNano()
+	for _, ck := range checks {
+		n := ck.node
+		if m.nodes[ck.name] != n {
+			// untracked or replaced in the meantime
+			continue
+		}
+		n.pid = ck.pid
+		if ck.exited {
+			n.exited, n.exitCode = true, ck.exitCode
+			n.state = healthUnhealthy
+			msg := fmt.Sprintf("process %d exited", ck.pid)
+			m.emit(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_EXITED, NodeName: ck.name, ExitCode: ck.exitCode, Message: msg})
+			m.onExit(ck.name, ck.exitCode)
+			continue
+		}
+		if !ck.checked || n.paused {
+			continue
+		}
+		n.checks, n.healthErr, n.lastChecked = ck.checks, ck.healthErr, now
+		if ck.state == n.state {
+			continue
+		}
+		n.state = ck.state
+		typ := rpcpb.EventType_EVENT_TYPE_NODE_HEALTHY
+		if ck.state == healthUnhealthy {
+			typ = rpcpb.EventType_EVENT_TYPE_NODE_UNHEALTHY
+		}
+		m.emit(&rpcpb.Event{Type: typ, NodeName: ck.name, Message: ck.msg})
+		if ck.state == healthHealthy {
+			m.onHealthy(ck.name)
+		}
+	}
+}
+
+// apply sets the process and health states of the tracked nodes
+// in [infos], which are copies owned by the caller.
+func (m *nodeMonitor) apply(infos map[string]*rpcpb.NodeInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for name, info := range infos {
+		n, ok := m.nodes[name]
+		if !ok {
+			continue
+		}
+		info.Pid = int32(n.pid)
+		switch {
+		case n.exited:
+			info.State = rpcpb.NodeState_NODE_STATE_EXITED
+			info.ExitCode = n.exitCode
+		case n.pid == 0:
+			info.State = rpcpb.NodeState_NODE_STATE_STARTING
+		case info.Paused:
+			info.State = rpcpb.NodeState_NODE_STATE_PAUSED
+		default:
+			info.State = rpcpb.NodeState_NODE_STATE_RUNNING
+		}
+		info.Healthy = n.state == healthHealthy
+		info.HealthChecks = make(map[string]*rpcpb.HealthCheck, len(n.checks))
+		for k, v := range n.checks {
+			info.HealthChecks[k] = proto.Clone(v).(*rpcpb.HealthCheck)
+		}
+		info.HealthError = n.healthErr
+		info.LastChecked = n.lastChecked
+	}
+}
+
+func toHealthChecks(reply *health.APIHealthClientReply) map[string]*rpcpb.HealthCheck {
+	checks := make(map[string]*rpcpb.HealthCheck, len(reply.Checks))
+	for name, res := range reply.Checks {
+		ck := &rpcpb.HealthCheck{
+			Error:              res.Error.Message,
+			Timestamp:          res.Timestamp.UnixNano(),
+			ContiguousFailures: res.ContiguousFailures,
+		}
+		if res.Details != nil {
+			if b, err := json.Marshal(res.Details); err == nil {
+				ck.Details = string(b)
+			}
+		}
+		checks[name] = ck
+	}
+	return checks
+}
+
+// failingChecks returns the names of the failing health checks.
+func failingChecks(reply *health.APIHealthClientReply) string {
+	names := make([]string, 0, len(reply.Checks))
+	for name, res := range reply.Checks {
+		if res.Error.Message != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return "failing checks: " + strings.Join(names, ", ")
+}
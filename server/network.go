@@ -5,10 +5,12 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -18,6 +20,8 @@ import (
 	"github.com/ava-labs/avalanche-network-runner/local"
 	"github.com/ava-labs/avalanche-network-runner/network"
 	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/gyuho/avax-tester/pkg/color"
@@ -31,6 +35,11 @@ type localNetwork struct {
 	binPath string
 	cfg     network.Config
 
+	rootDataDir        string
+	logLevel           string
+	whitelistedSubnets string
+	globalNodeConfig   string
+
 	nw network.Network
 
 	nodeNames []string
@@ -39,6 +48,32 @@ type localNetwork struct {
 
 	apiClis map[string]api.Client
 
+	// node process output, kept for "StreamLogs"
+	logs *nodeLogs
+
+	clusterName string
+	events      *events
+	monitor     *nodeMonitor
+	supervisor  *supervisor
+
+	// defaults of the added nodes
+	restartPolicy *rpcpb.RestartPolicy
+	chainConfigs  map[string]*rpcpb.ChainConfig
+
+	ports     *portAllocator
+	portRange *rpcpb.PortRange
+
+	// true if the last health check passed
+	healthy bool
+
+	// keystore wallet for the pre-funded key
+	walletNode string
+	walletAddr string
+
+	// subnets and blockchains created by the server
+	subnetIDs   []string
+	blockchains []*rpcpb.BlockchainInfo
+
 	readyc          chan struct{} // closed when local network is ready/healthy
 	readycCloseOnce sync.Once
 
@@ -46,96 +81,329 @@ type localNetwork struct {
 	donec chan struct{}
 	errc  chan error
 
-	stopOnce sync.Once
+	abortOnce sync.Once
+	stopOnce  sync.Once
 }
 
-func newNetwork(execPath string, rootDataDir string, whitelistedSubnets string, logLevel string) (*localNetwork, error) {
-	lcfg, err := logging.DefaultConfig()
-	if err != nil {
+type localNetworkOptions struct {
+	execPath           string
+	rootDataDir        string
+	numNodes           uint32
+	nodeSpecs          []*rpcpb.NodeSpec
+	whitelistedSubnets string
+	logLevel           string
+	globalNodeConfig   string
+	genesis            []byte
+	genesisParams      *rpcpb.GenesisParams
+
+	restartPolicy *rpcpb.RestartPolicy
+	portRange     *rpcpb.PortRange
+	ports         *portAllocator
+	chainConfigs  map[string]*rpcpb.ChainConfig
+
+	// for the events
+	clusterName string
+	events      *events
+}
+
+const defaultNumNodes = 5
+
+var (
+	ErrInvalidNumNodes  = errors.New("invalid number of nodes")
+	ErrTooManyNodeSpecs = errors.New("more node specs than nodes")
+	ErrDuplicateNode    = errors.New("duplicate node name")
+	ErrInvalidConfig    = errors.New("invalid node config")
+)
+
+func newNetwork(opts localNetworkOptions) (*localNetwork, error) {
+	logLevel := opts.logLevel
+	if logLevel == "" {
+		logLevel = "INFO"
+	}
+
+	numNodes := opts.numNodes
+	if numNodes == 0 {
+		numNodes = uint32(len(opts.nodeSpecs))
+	}
+	if numNodes == 0 {
+		numNodes = defaultNumNodes
+	}
+	if int(numNodes) < len(opts.nodeSpecs) {
+		return nil, ErrTooManyNodeSpecs
+	}
+	if err := checkPortRange(opts.portRange); err != nil {
 		return nil, err
 	}
-	lcfg.Directory = rootDataDir
-	logFactory := logging.NewFactory(lcfg)
-	logger, err := logFactory.Make("main")
-	if err != nil {
+	if err := checkChainConfigs(opts.chainConfigs); err != nil {
 		return nil, err
 	}
 
-	if logLevel == "" {
-		logLevel = "INFO"
+	cfg := local.NewDefaultConfig(opts.execPath)
+	nodeConfigs, err := newNodeConfigs(cfg.NodeConfigs, int(numNodes))
+	if err != nil {
+		return nil, err
+	}
+	cfg.NodeConfigs = nodeConfigs
+	nodeIDs := make([]string, len(cfg.NodeConfigs))
+	seen := make(map[string]struct{}, len(cfg.NodeConfigs))
+	for i := range cfg.NodeConfigs {
+		var spec *rpcpb.NodeSpec
+		if i < len(opts.nodeSpecs) {
+			spec = opts.nodeSpecs[i]
+		}
+		nodeIDs[i], err = setStakingKey(&cfg.NodeConfigs[i], spec)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[nodeIDs[i]]; ok {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateNodeID, nodeIDs[i])
+		}
+		seen[nodeIDs[i]] = struct{}{}
+	}
+	if len(opts.genesis) > 0 {
+		if err := validateGenesis(opts.genesis, cfg.NodeConfigs); err != nil {
+			return nil, err
+		}
+		cfg.Genesis = opts.genesis
+	} else {
+		cfg.Genesis, err = newGenesis(cfg.Genesis, cfg.NodeConfigs, opts.genesisParams)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	nodeInfos := make(map[string]*rpcpb.NodeInfo)
-	cfg := local.NewDefaultConfig(execPath)
+	logs := newNodeLogs()
 	nodeNames := make([]string, len(cfg.NodeConfigs))
 	for i := range cfg.NodeConfigs {
 		nodeName := fmt.Sprintf("node%d", i+1)
-		logDir := filepath.Join(rootDataDir, nodeName, "log")
-		dbDir := filepath.Join(rootDataDir, nodeName, "db-dir")
+		execPath := opts.execPath
+		extraConfig := ""
+		var restartPolicy *rpcpb.RestartPolicy
+		var chainConfigs map[string]*rpcpb.ChainConfig
+		if i < len(opts.nodeSpecs) {
+			spec := opts.nodeSpecs[i]
+			if spec.Name != "" {
+				nodeName = spec.Name
+			}
+			if spec.ExecPath != "" {
+				execPath = spec.ExecPath
+			}
+			extraConfig = spec.Config
+			restartPolicy = spec.RestartPolicy
+			chainConfigs = spec.ChainConfigs
+		}
+		if _, ok := nodeInfos[nodeName]; ok {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateNode, nodeName)
+		}
+		if _, err := os.Stat(execPath); err != nil {
+			return nil, ErrNotExists
+		}
+		if err := checkChainConfigs(chainConfigs); err != nil {
+			return nil, fmt.Errorf("node %q: %w", nodeName, err)
+		}
+		chainConfigs = mergeChainConfigs(opts.chainConfigs, chainConfigs)
+
+		logDir := filepath.Join(opts.rootDataDir, nodeName, "log")
+		dbDir := filepath.Join(opts.rootDataDir, nodeName, "db-dir")
 
 		nodeNames[i] = nodeName
 		cfg.NodeConfigs[i].Name = nodeName
 
-		// need to whitelist subnet ID to create custom VM chain
-		// ref. vms/platformvm/createChain
-		cfg.NodeConfigs[i].ConfigFile = []byte(fmt.Sprintf(`{
-	"network-peer-list-gossip-frequency":"250ms",
-	"network-max-reconnect-delay":"1s",
-	"public-ip":"127.0.0.1",
-	"health-check-frequency":"2s",
-	"api-admin-enabled":true,
-	"api-ipcs-enabled":true,
-	"index-enabled":true,
-	"log-display-level":"INFO",
-	"log-level":"%s",
-	"log-dir":"%s",
-	"db-dir":"%s",
-	"whitelisted-subnets":"%s"
-}`,
-			strings.ToUpper(logLevel),
-			logDir,
-			dbDir,
-			whitelistedSubnets,
-		))
-		wr := &writer{
-			c:    colors[i%len(cfg.NodeConfigs)],
-			name: nodeName,
-			w:    os.Stdout,
+		base := defaultNodeConfig(logLevel, logDir, dbDir, opts.whitelistedSubnets)
+		if numNodes == 1 {
+			// a single node has no peer to connect to
+			base["network-health-min-conn-peers"] = 0
+		}
+		cfg.NodeConfigs[i].ConfigFile, err = mergeConfig(
+			base,
+			opts.globalNodeConfig,
+			extraConfig,
+			chainConfigOverride(opts.rootDataDir, nodeName, chainConfigs),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("node %q: %w", nodeName, err)
 		}
+		stdout, stderr := logs.writers(nodeName, colors[i%len(colors)])
 		cfg.NodeConfigs[i].ImplSpecificConfig = local.NodeConfig{
 			BinaryPath: execPath,
-			Stdout:     wr,
-			Stderr:     wr,
+			Stdout:     stdout,
+			Stderr:     stderr,
 		}
 
 		nodeInfos[nodeName] = &rpcpb.NodeInfo{
-			Name:               nodeName,
-			ExecPath:           execPath,
-			Uri:                "",
-			Id:                 "",
-			LogDir:             logDir,
-			DbDir:              dbDir,
-			WhitelistedSubnets: whitelistedSubnets,
-			Config:             cfg.NodeConfigs[i].ConfigFile,
+			Name:          nodeName,
+			ExecPath:      execPath,
+			Uri:           "",
+			Id:            nodeIDs[i],
+			RestartPolicy: mergeRestartPolicy(opts.restartPolicy, restartPolicy),
+			ChainConfigs:  chainConfigs,
+		}
+		if err := updateNodeInfo(nodeInfos[nodeName], cfg.NodeConfigs[i].ConfigFile); err != nil {
+			return nil, err
 		}
 	}
 
-	return &localNetwork{
+	opts.logLevel = logLevel
+	return newLocalNetwork(opts, cfg, nodeNames, nodeInfos, logs)
+}
+
+// newLocalNetwork returns a local network that runs [cfg]
+// once started, logging to [opts.rootDataDir].
+func newLocalNetwork(
+	opts localNetworkOptions,
+	cfg network.Config,
+	nodeNames []string,
+	nodeInfos map[string]*rpcpb.NodeInfo,
+	logs *nodeLogs,
+) (*localNetwork, error) {
+	lcfg, err := logging.DefaultConfig()
+	if err != nil {
+		return nil, err
+	}
+	lcfg.Directory = opts.rootDataDir
+	logFactory := logging.NewFactory(lcfg)
+	logger, err := logFactory.Make("main")
+	if err != nil {
+		return nil, err
+	}
+
+	lc := &localNetwork{
 		logger: logger,
 
-		binPath: execPath,
+		binPath: opts.execPath,
 		cfg:     cfg,
 
+		rootDataDir:        opts.rootDataDir,
+		logLevel:           opts.logLevel,
+		whitelistedSubnets: opts.whitelistedSubnets,
+		globalNodeConfig:   opts.globalNodeConfig,
+
 		nodeNames: nodeNames,
 		nodeInfos: nodeInfos,
 		apiClis:   make(map[string]api.Client),
+		logs:      logs,
+
+		clusterName:   opts.clusterName,
+		events:        opts.events,
+		restartPolicy: opts.restartPolicy,
+		chainConfigs:  opts.chainConfigs,
+		ports:         opts.ports,
+		portRange:     opts.portRange,
 
 		readyc: make(chan struct{}),
 
 		stopc: make(chan struct{}),
 		donec: make(chan struct{}),
 		errc:  make(chan error, 1),
-	}, nil
+	}
+	lc.supervisor = newSupervisor(lc.emit)
+	lc.monitor = newNodeMonitor(lc.emit, lc.supervisor.onExit, lc.supervisor.onHealthy)
+	return lc, nil
+}
+
+// emit publishes the event of the cluster.
+func (lc *localNetwork) emit(ev *rpcpb.Event) {
+	if lc.events == nil {
+		return
+	}
+	ev.ClusterName = lc.clusterName
+	lc.events.publish(ev)
+}
+
+// defaultNodeConfig returns the avalanchego config of a node,
+// to be overridden by the global and per-node configs.
+func defaultNodeConfig(logLevel string, logDir string, dbDir string, whitelistedSubnets string) map[string]interface{} {
+	return map[string]interface{}{
+		"network-peer-list-gossip-frequency": "250ms",
+		"network-max-reconnect-delay":        "1s",
+		"public-ip":                          "127.0.0.1",
+		"health-check-frequency":             "2s",
+		"api-admin-enabled":                  true,
+		"api-ipcs-enabled":                   true,
+		"index-enabled":                      true,
+		"log-display-level":                  "INFO",
+		"log-level":                          strings.ToUpper(logLevel),
+		"log-dir":                            logDir,
+		"db-dir":                             dbDir,
+		// need to whitelist subnet ID to create custom VM chain
+		// ref. vms/platformvm/createChain
+		"whitelisted-subnets": whitelistedSubnets,
+	}
+}
+
+// newNodeConfigs returns [n] node configs, reusing the staking keys
+// of the default configs and generating new ones for the rest. Only
+// the default nodes are beacons.
+func newNodeConfigs(defaults []node.Config, n int) ([]node.Config, error) {
+	if n < 1 {
+		return nil, ErrInvalidNumNodes
+	}
+	nodeConfigs := make([]node.Config, n)
+	for i := range nodeConfigs {
+		if i < len(defaults) {
+			nodeConfigs[i] = defaults[i]
+			continue
+		}
+		stakingCert, stakingKey, err := staking.NewCertAndKeyBytes()
+		if err != nil {
+			return nil, err
+		}
+		nodeConfigs[i] = node.Config{
+			StakingKey:  stakingKey,
+			StakingCert: stakingCert,
+		}
+	}
+	return nodeConfigs, nil
+}
+
+// mergeConfig overlays the JSON objects in [overrides], in order,
+// on top of [base], and returns the merged JSON object.
+func mergeConfig(base map[string]interface{}, overrides ...string) ([]byte, error) {
+	merged := make(map[string]interface{}, len(base))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for _, override := range overrides {
+		if override == "" {
+			continue
+		}
+		m := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(override), &m); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+		}
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return json.Marshal(merged)
+}
+
+// updateNodeInfo reports the merged [configFile] of a node in [info],
+// along with the directories and subnets it may have overridden.
+func updateNodeInfo(info *rpcpb.NodeInfo, configFile []byte) error {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(configFile, &m); err != nil {
+		return err
+	}
+	if v, ok := m["log-dir"].(string); ok {
+		info.LogDir = v
+	}
+	if v, ok := m["db-dir"].(string); ok {
+		info.DbDir = v
+	}
+	if v, ok := m["whitelisted-subnets"].(string); ok {
+		info.WhitelistedSubnets = v
+	}
+	if v, ok := m[httpPortKey].(float64); ok {
+		info.ApiPort = uint32(v)
+	}
+	if v, ok := m[stakingPortKey].(float64); ok {
+		info.StakingPort = uint32(v)
+	}
+	info.ChainConfigDir, _ = m[chainConfigDirKey].(string)
+	info.Config = configFile
+	return nil
 }
 
 func (lc *localNetwork) start() {
@@ -143,6 +411,33 @@ func (lc *localNetwork) start() {
 		close(lc.donec)
 	}()
 
+	launches := make([]*nodeLaunch, len(lc.cfg.NodeConfigs))
+	for i, cfg := range lc.cfg.NodeConfigs {
+		configFile, err := lc.assignPorts(cfg.Name, cfg.ConfigFile, false)
+		if err != nil {
+			lc.ports.release(lc.clusterName, "")
+			lc.errc <- err
+			return
+		}
+		lc.cfg.NodeConfigs[i].ConfigFile = configFile
+		if err := updateNodeInfo(lc.nodeInfos[cfg.Name], configFile); err != nil {
+			lc.ports.release(lc.clusterName, "")
+			lc.errc <- err
+			return
+		}
+		if err := lc.linkPlugins(cfg.Name, configFile, lc.nodeInfos[cfg.Name].ExecPath); err != nil {
+			lc.ports.release(lc.clusterName, "")
+			lc.errc <- err
+			return
+		}
+		if err := lc.writeChainConfigs(lc.nodeInfos[cfg.Name]); err != nil {
+			lc.ports.release(lc.clusterName, "")
+			lc.errc <- err
+			return
+		}
+		launches[i] = newLaunch(&lc.cfg.NodeConfigs[i], lc.nodeInfos[cfg.Name])
+	}
+
 	color.Outf("{{blue}}{{bold}}create and run local network{{/}}\n")
 	nw, err := local.NewNetwork(lc.logger, lc.cfg)
 	if err != nil {
@@ -150,33 +445,355 @@ func (lc *localNetwork) start() {
 		return
 	}
 	lc.nw = nw
+	if err := lc.retryPortConflicts(launches); err != nil {
+		lc.errc <- err
+		return
+	}
+
+	nodes, err := nw.GetAllNodes()
+	if err != nil {
+		lc.errc <- err
+		return
+	}
+	now := time.Now().UnixNano()
+	for _, name := range lc.nodeNames {
+		lc.nodeInfos[name].StartTime = now
+		lc.supervisor.track(name, lc.nodeInfos[name].RestartPolicy)
+		lc.monitor.track(name, lc.nodeInfos[name].DbDir, nodes[name].GetAPIClient().HealthAPI())
+		lc.emit(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED, NodeName: name})
+	}
+	go lc.monitor.run()
 
-	if err := lc.waitForHealthy(); err != nil {
+	if err := lc.waitForHealthy(context.Background()); err != nil {
 		lc.errc <- err
 		return
 	}
 }
 
+// addNode starts a new node with a newly generated staking key, and waits
+// for the network to be healthy. If [validator] is true, the node is then
+// added to the primary network validator set.
+func (lc *localNetwork) addNode(ctx context.Context, spec *rpcpb.NodeSpec, validator bool) error {
+	nodeName := spec.GetName()
+	for i := len(lc.nodeNames) + 1; nodeName == ""; i++ {
+		if _, ok := lc.nodeInfos[fmt.Sprintf("node%d", i)]; !ok {
+			nodeName = fmt.Sprintf("node%d", i)
+		}
+	}
+	if _, ok := lc.nodeInfos[nodeName]; ok {
+		return fmt.Errorf("%w: %q", ErrDuplicateNode, nodeName)
+	}
+	execPath := spec.GetExecPath()
+	if execPath == "" {
+		execPath = lc.binPath
+	}
+	if _, err := os.Stat(execPath); err != nil {
+		return ErrNotExists
+	}
+
+	nodeConfig := node.Config{Name: nodeName}
+	if spec.GetStakingCert() == "" && spec.GetStakingKey() == "" {
+		var err error
+		nodeConfig.StakingCert, nodeConfig.StakingKey, err = staking.NewCertAndKeyBytes()
+		if err != nil {
+			return err
+		}
+	}
+	nodeID, err := setStakingKey(&nodeConfig, spec)
+	if err != nil {
+		return err
+	}
+	for _, info := range lc.nodeInfos {
+		if info.Id == nodeID {
+			return fmt.Errorf("%w: %q", ErrDuplicateNodeID, nodeID)
+		}
+	}
+	if err := checkChainConfigs(spec.GetChainConfigs()); err != nil {
+		return err
+	}
+	chainConfigs := mergeChainConfigs(lc.chainConfigs, spec.GetChainConfigs())
+	logDir := filepath.Join(lc.rootDataDir, nodeName, "log")
+	dbDir := filepath.Join(lc.rootDataDir, nodeName, "db-dir")
+	configFile, err := mergeConfig(
+		defaultNodeConfig(lc.logLevel, logDir, dbDir, lc.whitelistedSubnets),
+		lc.globalNodeConfig,
+		spec.GetConfig(),
+		chainConfigOverride(lc.rootDataDir, nodeName, chainConfigs),
+	)
+	if err != nil {
+		return err
+	}
+	configFile, err = lc.assignPorts(nodeName, configFile, false)
+	if err != nil {
+		return err
+	}
+	policy := mergeRestartPolicy(lc.restartPolicy, spec.GetRestartPolicy())
+	info := &rpcpb.NodeInfo{
+		Name:          nodeName,
+		ExecPath:      execPath,
+		Id:            nodeID,
+		RestartPolicy: policy,
+		ChainConfigs:  chainConfigs,
+	}
+	if err := updateNodeInfo(info, configFile); err != nil {
+		lc.ports.release(lc.clusterName, nodeName)
+		return err
+	}
+	if err := lc.linkPlugins(nodeName, configFile, execPath); err != nil {
+		lc.ports.release(lc.clusterName, nodeName)
+		return err
+	}
+	if err := lc.writeChainConfigs(info); err != nil {
+		lc.ports.release(lc.clusterName, nodeName)
+		return err
+	}
+	stdout, stderr := lc.logs.writers(nodeName, colors[len(lc.nodeNames)%len(colors)])
+	nodeConfig.ConfigFile = configFile
+	nodeConfig.ImplSpecificConfig = local.NodeConfig{
+		BinaryPath: execPath,
+		Stdout:     stdout,
+		Stderr:     stderr,
+	}
+
+	color.Outf("{{blue}}{{bold}}adding node %q{{/}}\n", nodeName)
+	launch := newLaunch(&nodeConfig, info)
+	if _, err := lc.nw.AddNode(nodeConfig); err != nil {
+		lc.ports.release(lc.clusterName, nodeName)
+		return err
+	}
+	if err := lc.retryPortConflicts([]*nodeLaunch{launch}); err != nil {
+		if rerr := lc.removeRunnerNode(nodeName); rerr != nil {
+			color.Outf("{{red}}failed to remove node %q:{{/}} %v\n", nodeName, rerr)
+		}
+		lc.ports.release(lc.clusterName, nodeName)
+		return err
+	}
+	n, err := lc.nw.GetNode(nodeName)
+	if err != nil {
+		if rerr := lc.removeRunnerNode(nodeName); rerr != nil {
+			color.Outf("{{red}}failed to remove node %q:{{/}} %v\n", nodeName, rerr)
+		}
+		lc.ports.release(lc.clusterName, nodeName)
+		return err
+	}
+	lc.supervisor.track(nodeName, policy)
+	lc.monitor.track(nodeName, dbDir, n.GetAPIClient().HealthAPI())
+	lc.emit(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED, NodeName: nodeName})
+	lc.cfg.NodeConfigs = append(lc.cfg.NodeConfigs, nodeConfig)
+	lc.nodeNames = append(lc.nodeNames, nodeName)
+	info.StartTime = time.Now().UnixNano()
+	lc.nodeInfos[nodeName] = info
+
+	if err := lc.waitForHealthy(ctx); err != nil {
+		return err
+	}
+	if !validator {
+		return nil
+	}
+	validatorID, err := utils.ToNodeID(nodeConfig.StakingKey, nodeConfig.StakingCert)
+	if err != nil {
+		return err
+	}
+	return lc.addPrimaryValidator(ctx, validatorID)
+}
+
+// removeNode stops the node, and waits for the rest to be healthy.
+func (lc *localNetwork) removeNode(ctx context.Context, name string) error {
+	nodeInfo, ok := lc.nodeInfos[name]
+	if !ok {
+		return ErrNodeNotFound
+	}
+	if nodeInfo.Paused {
+		if err := lc.resumeNode(name); err != nil {
+			return err
+		}
+	}
+
+	lc.monitor.untrack(name)
+	lc.supervisor.untrack(name)
+	color.Outf("{{blue}}{{bold}}removing node %q{{/}}\n", name)
+	if err := lc.removeRunnerNode(name); err != nil {
+		return err
+	}
+	lc.ports.release(lc.clusterName, name)
+	lc.emit(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_REMOVED, NodeName: name})
+	delete(lc.nodeInfos, name)
+	delete(lc.apiClis, name)
+	nodeNames := make([]string, 0, len(lc.nodeNames))
+	for _, n := range lc.nodeNames {
+		if n != name {
+			nodeNames = append(nodeNames, n)
+		}
+	}
+	lc.nodeNames = nodeNames
+
+	return lc.waitForHealthy(ctx)
+}
+
+// restartNode restarts the node with [execPath], keeping everything
+// else the same except the whitelisted subnets and [config], which is
+// merged over the current node config. Then waits for the network to
+// be healthy.
+func (lc *localNetwork) restartNode(ctx context.Context, name string, execPath string, whitelistedSubnets string, config string) error {
+	if err := lc.replaceNode(name, execPath, whitelistedSubnets, config); err != nil {
+		return err
+	}
+	return lc.waitForHealthy(ctx)
+}
+
+// replaceNode stops the node, and starts it again with the same staking
+// key and database with [execPath] (current binary if empty).
+func (lc *localNetwork) replaceNode(name string, execPath string, whitelistedSubnets string, config string) error {
+	nodeInfo, ok := lc.nodeInfos[name]
+	if !ok {
+		return ErrNodeNotFound
+	}
+	if nodeInfo.Paused {
+		if err := lc.resumeNode(name); err != nil {
+			return err
+		}
+	}
+
+	found, idx := false, 0
+	oldNodeConfig := node.Config{}
+	for i, cfg := range lc.cfg.NodeConfigs {
+		if cfg.Name == name {
+			oldNodeConfig = cfg
+			found = true
+			idx = i
+			break
+		}
+	}
+	if !found {
+		return ErrNodeNotFound
+	}
+	nodeConfig := oldNodeConfig
+	if execPath == "" {
+		execPath = nodeInfo.ExecPath
+	}
+
+	current := make(map[string]interface{})
+	if err := json.Unmarshal(nodeInfo.Config, &current); err != nil {
+		return err
+	}
+	current["whitelisted-subnets"] = whitelistedSubnets
+	dir := chainConfigDir(lc.rootDataDir, name)
+	if len(nodeInfo.ChainConfigs) > 0 {
+		current[chainConfigDirKey] = dir
+	} else if current[chainConfigDirKey] == dir {
+		delete(current, chainConfigDirKey)
+	}
+	configFile, err := mergeConfig(current, config)
+	if err != nil {
+		return err
+	}
+	nodeConfig.ConfigFile = configFile
+	nodeInfo.ExecPath = execPath
+	if err := updateNodeInfo(nodeInfo, configFile); err != nil {
+		return err
+	}
+	lcfg, ok := nodeConfig.ImplSpecificConfig.(local.NodeConfig)
+	if !ok {
+		return ErrUnexpectedType
+	}
+	lcfg.BinaryPath = nodeInfo.ExecPath
+	nodeConfig.ImplSpecificConfig = lcfg
+
+	// now remove the node before restart
+	lc.emit(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_RESTARTING, NodeName: name, Message: execPath})
+	lc.monitor.untrack(name)
+	relaunched := false
+	defer func() {
+		if !relaunched {
+			// keep reporting the node as exited, so that the supervisor
+			// retries the restart
+			lc.monitor.markExited(name, nodeInfo.DbDir)
+		}
+	}()
+	// already removed if the last relaunch failed
+	if _, err := lc.nw.GetNode(name); err == nil {
+		color.Outf("{{blue}}{{bold}}removing node %q{{/}}\n", name)
+		if err := lc.removeRunnerNode(name); err != nil {
+			return err
+		}
+	}
+	// the ports are free once the node is removed
+	nodeConfig.ConfigFile, err = lc.assignPorts(name, nodeConfig.ConfigFile, nodeConfig.IsBeacon)
+	if err != nil {
+		return err
+	}
+	if err := updateNodeInfo(nodeInfo, nodeConfig.ConfigFile); err != nil {
+		return err
+	}
+	if err := lc.linkPlugins(name, nodeConfig.ConfigFile, execPath); err != nil {
+		return err
+	}
+	if err := lc.writeChainConfigs(nodeInfo); err != nil {
+		return err
+	}
+
+	// now adding the new node
+	color.Outf("{{blue}}{{bold}}adding node %q{{/}}\n", name)
+	launch := newLaunch(&nodeConfig, nodeInfo)
+	if _, err := lc.nw.AddNode(nodeConfig); err != nil {
+		return err
+	}
+	if err := lc.retryPortConflicts([]*nodeLaunch{launch}); err != nil {
+		return err
+	}
+	n, err := lc.nw.GetNode(name)
+	if err != nil {
+		return err
+	}
+	relaunched = true
+	nodeInfo.StartTime = time.Now().UnixNano()
+	nodeInfo.RestartCount++
+	lc.monitor.track(name, nodeInfo.DbDir, n.GetAPIClient().HealthAPI())
+	lc.emit(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_RESTARTED, NodeName: name, Message: execPath})
+	// update with the new config
+	lc.cfg.NodeConfigs[idx] = nodeConfig
+	return nil
+}
+
+// removeRunnerNode stops the node process, and removes it from the runner.
+// The runner fails when the process already exited with an error,
+// after removing the node.
+func (lc *localNetwork) removeRunnerNode(name string) error {
+	err := lc.nw.RemoveNode(name)
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		color.Outf("{{yellow}}node %q had exited:{{/}} %v\n", name, exitErr)
+		return nil
+	}
+	return err
+}
+
 const healthyWait = 2 * time.Minute
 
 var errAborted = errors.New("aborted")
 
-func (lc *localNetwork) waitForHealthy() error {
+func (lc *localNetwork) waitForHealthy(ctx context.Context) error {
 	color.Outf("{{blue}}{{bold}}waiting for all nodes to report healthy...{{/}}\n")
 
-	ctx, cancel := context.WithTimeout(context.Background(), healthyWait)
-	defer cancel()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, healthyWait)
+		defer cancel()
+	}
 	hc := lc.nw.Healthy(ctx)
 	select {
 	case <-lc.stopc:
 		return errAborted
 	case <-ctx.Done():
+		lc.healthy = false
 		return ctx.Err()
 	case err := <-hc:
 		if err != nil {
+			lc.healthy = false
 			return err
 		}
 	}
+	lc.healthy = true
 
 	nodes, err := lc.nw.GetAllNodes()
 	if err != nil {
@@ -201,11 +818,28 @@ func (lc *localNetwork) waitForHealthy() error {
 	return nil
 }
 
+// abort makes the ongoing waits return, without stopping the nodes.
+func (lc *localNetwork) abort() {
+	lc.abortOnce.Do(func() {
+		close(lc.stopc)
+	})
+}
+
 func (lc *localNetwork) stop() {
 	lc.stopOnce.Do(func() {
-		close(lc.stopc)
-		serr := lc.nw.Stop(context.Background())
+		defer lc.ports.release(lc.clusterName, "")
+		lc.abort()
+		// wait for the start, so that nodes launched in the meantime are stopped
 		<-lc.donec
+		if lc.nw == nil {
+			// failed to create, and the runner stopped the launched nodes
+			color.Outf("{{red}}{{bold}}terminated network{{/}}\n")
+			return
+		}
+		lc.monitor.close()
+		lc.supervisor.close()
+		lc.resumePausedNodes()
+		serr := lc.nw.Stop(context.Background())
 		color.Outf("{{red}}{{bold}}terminated network{{/}} (error %v)\n", serr)
 	})
 }
@@ -214,6 +848,7 @@ type writer struct {
 	c    string
 	name string
 	w    io.Writer
+	buf  *logBuffer
 }
 
 // https://github.com/onsi/ginkgo/blob/v2.0.0/formatter/formatter.go#L52-L73
@@ -228,5 +863,8 @@ var colors = []string{
 func (wr *writer) Write(p []byte) (n int, err error) {
 	s := formatter.F(wr.c+"[%s]{{/}}	", wr.name)
 	fmt.Fprint(formatter.ColorableStdOut, s)
+	if _, err := wr.buf.Write(p); err != nil {
+		return 0, err
+	}
 	return wr.w.Write(p)
 }
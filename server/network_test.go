@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/gyuho/avax-tester/rpcpb"
+)
+
+// newFakeBinary writes an executable that runs until killed, in place of
+// avalanchego, so that the nodes launch but never become healthy.
+func newFakeBinary(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "avalanchego")
+	if err := ioutil.WriteFile(path, []byte("#!/bin/sh\nexec sleep 300\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// newTestNetwork returns a network of [numNodes] nodes running [execPath],
+// which is not started, with the node databases created.
+func newTestNetwork(t *testing.T, execPath string, numNodes uint32, chainConfigs map[string]*rpcpb.ChainConfig) *localNetwork {
+	t.Helper()
+	nw, err := newNetwork(localNetworkOptions{
+		execPath:     execPath,
+		rootDataDir:  t.TempDir(),
+		numNodes:     numNodes,
+		chainConfigs: chainConfigs,
+		ports:        newPortAllocator(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// flush the logs before the data directory is removed
+	t.Cleanup(nw.logger.Stop)
+	for _, info := range nw.nodeInfos {
+		if err := os.MkdirAll(info.DbDir, 0o750); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return nw
+}
+
+func TestMergeConfig(t *testing.T) {
+	base := map[string]interface{}{"log-level": "INFO", "db-dir": "/tmp/db", "index-enabled": true}
+	b, err := mergeConfig(
+		base,
+		`{"log-level":"DEBUG","http-port":9650}`,
+		"",
+		`{"log-level":"TRACE","index-enabled":false}`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"log-level":     "TRACE",
+		"db-dir":        "/tmp/db",
+		"index-enabled": false,
+		"http-port":     float64(9650),
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Fatalf("expected %v, got %v", expected, m)
+	}
+	// the base is not modified
+	if base["log-level"] != "INFO" {
+		t.Fatalf("base modified %v", base)
+	}
+
+	if _, err := mergeConfig(base, `{"log-level":`); !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("expected %v, got %v", ErrInvalidConfig, err)
+	}
+	if _, err := mergeConfig(base, `[]`); !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("expected %v, got %v", ErrInvalidConfig, err)
+	}
+}
+
+func TestNewNetworkNodeConfig(t *testing.T) {
+	execPath := newFakeBinary(t)
+	nw, err := newNetwork(localNetworkOptions{
+		execPath:         execPath,
+		rootDataDir:      t.TempDir(),
+		numNodes:         2,
+		globalNodeConfig: `{"log-level":"DEBUG","http-port":9660}`,
+		nodeSpecs: []*rpcpb.NodeSpec{
+			{Name: "custom", Config: `{"http-port":9670,"db-dir":"/tmp/custom-db"}`},
+		},
+		ports: newPortAllocator(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(nw.logger.Stop)
+
+	if !reflect.DeepEqual(nw.nodeNames, []string{"custom", "node2"}) {
+		t.Fatalf("unexpected node names %v", nw.nodeNames)
+	}
+	custom, node2 := nw.nodeInfos["custom"], nw.nodeInfos["node2"]
+	if custom.DbDir != "/tmp/custom-db" || node2.DbDir == custom.DbDir {
+		t.Fatalf("node config not applied %+v, %+v", custom, node2)
+	}
+	if custom.ApiPort != 9670 || node2.ApiPort != 9660 {
+		t.Fatalf("unexpected API ports %d, %d", custom.ApiPort, node2.ApiPort)
+	}
+	for info, port := range map[*rpcpb.NodeInfo]float64{custom: 9670, node2: 9660} {
+		m := make(map[string]interface{})
+		if err := json.Unmarshal(info.Config, &m); err != nil {
+			t.Fatal(err)
+		}
+		if m["log-level"] != "DEBUG" || m["http-port"] != port {
+			t.Fatalf("%q: global config not applied %v", info.Name, m)
+		}
+	}
+}
+
+func TestNewNodeConfigsBeacons(t *testing.T) {
+	defaults := local.NewDefaultConfig("avalanchego").NodeConfigs
+	nodeConfigs, err := newNodeConfigs(defaults, len(defaults)+2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, cfg := range nodeConfigs {
+		if beacon := i < len(defaults); cfg.IsBeacon != beacon {
+			t.Fatalf("node %d: expected beacon %v, got %v", i, beacon, cfg.IsBeacon)
+		}
+	}
+	if _, err := newNodeConfigs(defaults, 0); !errors.Is(err, ErrInvalidNumNodes) {
+		t.Fatalf("expected %v, got %v", ErrInvalidNumNodes, err)
+	}
+}
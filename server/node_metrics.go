@@ -0,0 +1,260 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+)
+
+const nodeMetricsTimeout = 10 * time.Second
+
+var nodeMetricsClient = &http.Client{Timeout: nodeMetricsTimeout}
+
+type nodeScrape struct {
+	name   string
+	nodeID string
+	mfs    map[string]*dto.MetricFamily
+	err    error
+}
+
+// scrapeNodes fetches "/ext/metrics" from the nodes in [nodeNames]
+// (all nodes, if empty), keeping only the families in [metricNames]
+// (all families, if empty).
+func scrapeNodes(ctx context.Context, info *rpcpb.ClusterInfo, nodeNames []string, metricNames []string) ([]*nodeScrape, error) {
+	if len(nodeNames) == 0 {
+		nodeNames = info.NodeNames
+	}
+	scrapes := make([]*nodeScrape, len(nodeNames))
+	for i, name := range nodeNames {
+		nodeInfo, ok := info.NodeInfos[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrNodeNotFound, name)
+		}
+		scrapes[i] = &nodeScrape{name: name, nodeID: nodeInfo.Id}
+	}
+
+	var wg sync.WaitGroup
+	for _, sc := range scrapes {
+		wg.Add(1)
+		go func(sc *nodeScrape) {
+			defer wg.Done()
+			sc.mfs, sc.err = scrapeNode(ctx, info.NodeInfos[sc.name].Uri)
+			if sc.err != nil {
+				return
+			}
+			if len(metricNames) == 0 {
+				return
+			}
+			filtered := make(map[string]*dto.MetricFamily, len(metricNames))
+			for _, name := range metricNames {
+				if mf, ok := sc.mfs[name]; ok {
+					filtered[name] = mf
+				}
+			}
+			sc.mfs = filtered
+		}(sc)
+	}
+	wg.Wait()
+	return scrapes, nil
+}
+
+func scrapeNode(ctx context.Context, uri string) (map[string]*dto.MetricFamily, error) {
+	if uri == "" {
+		// not healthy yet
+		return nil, ErrNotHealthy
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri+"/ext/metrics", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := nodeMetricsClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(resp.Body)
+}
+
+func (s *server) GetNodeMetrics(ctx context.Context, req *rpcpb.GetNodeMetricsRequest) (*rpcpb.GetNodeMetricsResponse, error) {
+	zap.L().Debug("received get node metrics request")
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	info := c.getInfo()
+	if info == nil {
+		return nil, ErrNotBootstrapped
+	}
+	scrapes, err := scrapeNodes(ctx, info, req.GetNodeNames(), req.GetMetricNames())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetNodeMetricsResponse{NodeMetrics: make(map[string]*rpcpb.NodeMetrics, len(scrapes))}
+	for _, sc := range scrapes {
+		nm := &rpcpb.NodeMetrics{NodeId: sc.nodeID}
+		if sc.err != nil {
+			nm.Error = sc.err.Error()
+		}
+		for _, name := range sortedFamilyNames(sc.mfs) {
+			nm.MetricFamilies = append(nm.MetricFamilies, toMetricFamily(sc.mfs[name]))
+		}
+		resp.NodeMetrics[sc.name] = nm
+	}
+	return resp, nil
+}
+
+// serveNodeMetrics serves the metrics of all nodes in the text format,
+// with the "node_name" and "node_id" labels added to each series.
+// The query parameters "cluster", "node" and "name" select the cluster,
+// the nodes and the metric families.
+func (s *server) serveNodeMetrics(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	c, err := s.getCluster(q.Get("cluster"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	info := c.getInfo()
+	if info == nil {
+		http.Error(w, ErrNotBootstrapped.Error(), http.StatusNotFound)
+		return
+	}
+	scrapes, err := scrapeNodes(r.Context(), info, q["node"], q["name"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// the same families of all nodes are written once
+	merged := make(map[string]*dto.MetricFamily)
+	for _, sc := range scrapes {
+		if sc.err != nil {
+			zap.L().Warn("failed to scrape node metrics", zap.String("node", sc.name), zap.Error(sc.err))
+			continue
+		}
+		for name, mf := range sc.mfs {
+			for _, m := range mf.Metric {
+				m.Label = append(m.Label, labelPair("node_name", sc.name), labelPair("node_id", sc.nodeID))
+				sort.Slice(m.Label, func(i, j int) bool {
+					return m.Label[i].GetName() < m.Label[j].GetName()
+				})
+			}
+			if prev, ok := merged[name]; ok {
+				prev.Metric = append(prev.Metric, mf.Metric...)
+				continue
+			}
+			merged[name] = mf
+		}
+	}
+
+	format := expfmt.FmtText
+	w.Header().Set("Content-Type", string(format))
+	enc := expfmt.NewEncoder(w, format)
+	for _, name := range sortedFamilyNames(merged) {
+		if err := enc.Encode(merged[name]); err != nil {
+			zap.L().Warn("failed to encode node metrics", zap.String("name", name), zap.Error(err))
+			return
+		}
+	}
+}
+
+func labelPair(name string, value string) *dto.LabelPair {
+	return &dto.LabelPair{Name: &name, Value: &value}
+}
+
+func sortedFamilyNames(mfs map[string]*dto.MetricFamily) []string {
+	names := make([]string, 0, len(mfs))
+	for name := range mfs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func toMetricFamily(mf *dto.MetricFamily) *rpcpb.MetricFamily {
+	name := mf.GetName()
+	ret := &rpcpb.MetricFamily{
+		Name: name,
+		Type: toMetricType(mf.GetType()),
+		Help: mf.GetHelp(),
+	}
+	add := func(suffix string, labels map[string]string, value float64) {
+		ret.Samples = append(ret.Samples, &rpcpb.MetricSample{Name: name + suffix, Labels: labels, Value: value})
+	}
+	for _, m := range mf.Metric {
+		labels := make(map[string]string, len(m.Label))
+		for _, lp := range m.Label {
+			labels[lp.GetName()] = lp.GetValue()
+		}
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			add("", labels, m.GetCounter().GetValue())
+		case dto.MetricType_GAUGE:
+			add("", labels, m.GetGauge().GetValue())
+		case dto.MetricType_UNTYPED:
+			add("", labels, m.GetUntyped().GetValue())
+		case dto.MetricType_SUMMARY:
+			for _, q := range m.GetSummary().GetQuantile() {
+				add("", withLabel(labels, "quantile", formatFloat(q.GetQuantile())), q.GetValue())
+			}
+			add("_sum", labels, m.GetSummary().GetSampleSum())
+			add("_count", labels, float64(m.GetSummary().GetSampleCount()))
+		case dto.MetricType_HISTOGRAM:
+			for _, b := range m.GetHistogram().GetBucket() {
+				add("_bucket", withLabel(labels, "le", formatFloat(b.GetUpperBound())), float64(b.GetCumulativeCount()))
+			}
+			add("_sum", labels, m.GetHistogram().GetSampleSum())
+			add("_count", labels, float64(m.GetHistogram().GetSampleCount()))
+		}
+	}
+	return ret
+}
+
+func toMetricType(typ dto.MetricType) string {
+	switch typ {
+	case dto.MetricType_COUNTER:
+		return "counter"
+	case dto.MetricType_GAUGE:
+		return "gauge"
+	case dto.MetricType_SUMMARY:
+		return "summary"
+	case dto.MetricType_HISTOGRAM:
+		return "histogram"
+	default:
+		return "untyped"
+	}
+}
+
+func withLabel(labels map[string]string, name string, value string) map[string]string {
+	ret := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		ret[k] = v
+	}
+	ret[name] = value
+	return ret
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gyuho/avax-tester/pkg/randutil"
+	"github.com/gyuho/avax-tester/rpcpb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// finished operations kept for GetOperation/ListOperations
+const maxFinishedOperations = 256
+
+var ErrOperationNotFound = errors.New("operation not found")
+
+type operation struct {
+	cancel context.CancelFunc
+	donec  chan struct{}
+
+	// protects "op" and "err"; "op" is replaced, not modified, once
+	// returned, so that it is safe to marshal without the lock
+	mu  sync.RWMutex
+	op  *rpcpb.Operation
+	err error
+}
+
+func (o *operation) get() *rpcpb.Operation {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.op
+}
+
+// update reports the progress of the running operation with [f],
+// which modifies a copy of the operation.
+func (o *operation) update(f func(op *rpcpb.Operation)) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	op := proto.Clone(o.op).(*rpcpb.Operation)
+	f(op)
+	o.op = op
+}
+
+// operations tracks the mutating requests running in the background,
+// so that the requests return right away without blocking status reads.
+type operations struct {
+	mu  sync.RWMutex
+	ops map[string]*operation
+	// in creation order
+	ids []string
+}
+
+func newOperations() *operations {
+	return &operations{ops: make(map[string]*operation)}
+}
+
+// run runs [f] on the cluster in the background until [ctx] is done
+// or the operation is canceled, and returns the running operation.
+func (ops *operations) run(ctx context.Context, typ string, c *cluster, f func(ctx context.Context, o *operation) error) *rpcpb.Operation {
+	ctx, cancel := context.WithCancel(ctx)
+	o := &operation{
+		cancel: cancel,
+		donec:  make(chan struct{}),
+		op: &rpcpb.Operation{
+			Id:          randutil.String(16),
+			Type:        typ,
+			ClusterName: c.name,
+			State:       rpcpb.OperationState_OPERATION_STATE_RUNNING,
+			StartTime:   time.Now().UnixNano(),
+			ClusterInfo: c.getInfo(),
+		},
+	}
+	ops.mu.Lock()
+	ops.ops[o.op.Id] = o
+	ops.ids = append(ops.ids, o.op.Id)
+	ops.prune()
+	ops.mu.Unlock()
+
+	zap.L().Info("running operation", zap.String("id", o.op.Id), zap.String("type", typ), zap.String("cluster", c.name))
+	running := o.op
+	go func() {
+		err := f(ctx, o)
+		canceled := ctx.Err() != nil
+		cancel()
+
+		op := proto.Clone(o.get()).(*rpcpb.Operation)
+		op.EndTime = time.Now().UnixNano()
+		op.ClusterInfo = c.getInfo()
+		switch {
+		case err == nil:
+			op.State = rpcpb.OperationState_OPERATION_STATE_SUCCEEDED
+		case canceled:
+			op.State = rpcpb.OperationState_OPERATION_STATE_CANCELED
+			op.Error = err.Error()
+		default:
+			op.State = rpcpb.OperationState_OPERATION_STATE_FAILED
+			op.Error = err.Error()
+		}
+		zap.L().Info("finished operation", zap.String("id", op.Id), zap.String("state", op.State.String()), zap.Error(err))
+
+		o.mu.Lock()
+		o.op, o.err = op, err
+		o.mu.Unlock()
+		close(o.donec)
+	}()
+	return running
+}
+
+// prune removes the oldest finished operations over the limit.
+// Assumes [ops.mu] is held.
+func (ops *operations) prune() {
+	finished := 0
+	for _, id := range ops.ids {
+		if ops.ops[id].get().State != rpcpb.OperationState_OPERATION_STATE_RUNNING {
+			finished++
+		}
+	}
+	ids := make([]string, 0, len(ops.ids))
+	for _, id := range ops.ids {
+		if finished > maxFinishedOperations && ops.ops[id].get().State != rpcpb.OperationState_OPERATION_STATE_RUNNING {
+			delete(ops.ops, id)
+			finished--
+			continue
+		}
+		ids = append(ids, id)
+	}
+	ops.ids = ids
+}
+
+func (ops *operations) getOperation(id string) (*operation, error) {
+	ops.mu.RLock()
+	defer ops.mu.RUnlock()
+	o, ok := ops.ops[id]
+	if !ok {
+		return nil, ErrOperationNotFound
+	}
+	return o, nil
+}
+
+func (ops *operations) get(id string) (*rpcpb.Operation, error) {
+	o, err := ops.getOperation(id)
+	if err != nil {
+		return nil, err
+	}
+	return o.get(), nil
+}
+
+// list returns the operations of the cluster (all if empty),
+// in the order they started.
+func (ops *operations) list(clusterName string) []*rpcpb.Operation {
+	ops.mu.RLock()
+	defer ops.mu.RUnlock()
+	list := make([]*rpcpb.Operation, 0, len(ops.ids))
+	for _, id := range ops.ids {
+		op := ops.ops[id].get()
+		if clusterName != "" && op.ClusterName != clusterName {
+			continue
+		}
+		list = append(list, op)
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].StartTime < list[j].StartTime
+	})
+	return list
+}
+
+// wait waits until the operation is done, and returns it with the
+// error of the operation. Returns [ctx] error if done first.
+func (ops *operations) wait(ctx context.Context, id string) (*rpcpb.Operation, error) {
+	o, err := ops.getOperation(id)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case <-o.donec:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.op, o.err
+}
+
+// cancel cancels the operation, and waits until it returns.
+func (ops *operations) cancel(ctx context.Context, id string) (*rpcpb.Operation, error) {
+	o, err := ops.getOperation(id)
+	if err != nil {
+		return nil, err
+	}
+	o.cancel()
+	select {
+	case <-o.donec:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return o.get(), nil
+}
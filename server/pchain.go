@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/api"
+	avaapi "github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/gyuho/avax-tester/pkg/color"
+)
+
+const (
+	// pre-funded in the default genesis
+	// ref. "local.NewDefaultNetwork"
+	ewoqPrivateKey = "PrivateKey-ewoqjP7PxY4yr3iLTpLisriqt94hdyDFNgchSxGGztUrTXtNN"
+
+	validatorStake         = 2 * units.KiloAvax
+	validatorStakeDuration = 14 * 24 * time.Hour
+	validatorStartDelay    = 30 * time.Second
+	validatorDelegationFee = 2
+
+	txPollInterval = time.Second
+	txWait         = 2 * time.Minute
+)
+
+var (
+	ErrNoAPIClient = errors.New("no API client available")
+	ErrTxDropped   = errors.New("transaction dropped")
+)
+
+// keystore user that holds the pre-funded key
+var ewoqUser = avaapi.UserPass{
+	Username: "network-runner",
+	Password: "network-runner-ewoq-Pa55word!",
+}
+
+// pChainWallet returns an API client with the pre-funded key imported
+// into its keystore, and the P-chain address of the key.
+// Assumes the network is healthy.
+func (lc *localNetwork) pChainWallet() (api.Client, string, error) {
+	names := make([]string, 0, len(lc.apiClis))
+	for name := range lc.apiClis {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, "", ErrNoAPIClient
+	}
+	// always use the same node, so that the keystore user is created only once
+	sort.Strings(names)
+	cli := lc.apiClis[names[0]]
+
+	if lc.walletAddr == "" || lc.walletNode != names[0] {
+		// user may already exist, ImportKey below fails otherwise
+		if _, err := cli.KeystoreAPI().CreateUser(ewoqUser); err != nil {
+			color.Outf("{{yellow}}failed to create keystore user (may already exist):{{/}} %v\n", err)
+		}
+		addr, err := cli.PChainAPI().ImportKey(ewoqUser, ewoqPrivateKey)
+		if err != nil {
+			return nil, "", err
+		}
+		lc.walletAddr, lc.walletNode = addr, names[0]
+	}
+	return cli, lc.walletAddr, nil
+}
+
+// addPrimaryValidator adds [nodeID] to the primary network validator set
+// with the pre-funded key, and waits until it is a current validator.
+func (lc *localNetwork) addPrimaryValidator(ctx context.Context, nodeID ids.ShortID) error {
+	cli, addr, err := lc.pChainWallet()
+	if err != nil {
+		return err
+	}
+
+	start := time.Now().Add(validatorStartDelay)
+	end := start.Add(validatorStakeDuration)
+	id := nodeID.PrefixedString(constants.NodeIDPrefix)
+	color.Outf("{{blue}}adding validator %q{{/}}\n", id)
+	txID, err := cli.PChainAPI().AddValidator(
+		ewoqUser,
+		[]string{addr},
+		addr,
+		addr,
+		id,
+		validatorStake,
+		uint64(start.Unix()),
+		uint64(end.Unix()),
+		validatorDelegationFee,
+	)
+	if err != nil {
+		return err
+	}
+	if err := waitForTxCommitted(ctx, cli.PChainAPI(), txID); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, validatorStartDelay+txWait)
+	defer cancel()
+	for {
+		vdrs, err := cli.PChainAPI().GetCurrentValidators(constants.PrimaryNetworkID, []ids.ShortID{nodeID})
+		if err == nil && len(vdrs) > 0 {
+			color.Outf("{{green}}%q is now a validator{{/}}\n", id)
+			return nil
+		}
+		select {
+		case <-lc.stopc:
+			return errAborted
+		case <-ctx.Done():
+			return fmt.Errorf("%q did not become a validator: %w", id, ctx.Err())
+		case <-time.After(txPollInterval):
+		}
+	}
+}
+
+// waitForTxCommitted polls the P-chain until [txID] is committed.
+func waitForTxCommitted(ctx context.Context, cli platformvm.Client, txID ids.ID) error {
+	ctx, cancel := context.WithTimeout(ctx, txWait)
+	defer cancel()
+	for {
+		resp, err := cli.GetTxStatus(txID, true)
+		if err == nil {
+			switch resp.Status {
+			case platformvm.Committed:
+				return nil
+			case platformvm.Dropped, platformvm.Aborted:
+				return fmt.Errorf("%w: %s (%s)", ErrTxDropped, txID, resp.Reason)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("tx %s not committed: %w", txID, ctx.Err())
+		case <-time.After(txPollInterval):
+		}
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/gyuho/avax-tester/pkg/color"
+	"github.com/gyuho/avax-tester/rpcpb"
+)
+
+const (
+	httpPortKey    = "http-port"
+	stakingPortKey = "staking-port"
+
+	// attempts to get a free port from the OS, not allocated to other nodes
+	maxPortAttempts = 10
+
+	// launches of a node that fails to bind its ports, with new ports
+	// allocated for each
+	maxLaunchAttempts = 3
+	// time to wait for a launched node to bind its ports, after which
+	// the health checks take over
+	portBindWait         = 10 * time.Second
+	portBindPollInterval = 100 * time.Millisecond
+	portBindProbeTimeout = time.Second
+
+	httpTLSEnabledKey = "http-tls-enabled"
+)
+
+// portBindClient probes the API of a launched node, which may serve
+// a self-signed certificate.
+var portBindClient = &http.Client{
+	Timeout: portBindProbeTimeout,
+	Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	},
+}
+
+var (
+	ErrInvalidPortRange = errors.New("invalid port range")
+	ErrNoFreePort       = errors.New("no free port")
+)
+
+type portOwner struct {
+	cluster string
+	node    string
+}
+
+// portAllocator keeps track of the node ports across the clusters,
+// so that the nodes of different clusters do not collide.
+type portAllocator struct {
+	mu   sync.Mutex
+	used map[uint16]portOwner
+}
+
+func newPortAllocator() *portAllocator {
+	return &portAllocator{used: make(map[uint16]portOwner)}
+}
+
+func checkPortRange(r *rpcpb.PortRange) error {
+	if r == nil {
+		return nil
+	}
+	if r.Start == 0 || r.Start > math.MaxUint16 || r.End > math.MaxUint16 || (r.End != 0 && r.End < r.Start) {
+		return fmt.Errorf("%w: [%d, %d]", ErrInvalidPortRange, r.Start, r.End)
+	}
+	return nil
+}
+
+// claim allocates [port] to [owner], if it is free and not allocated
+// to another node.
+func (pa *portAllocator) claim(owner portOwner, port uint16) bool {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	if o, ok := pa.used[port]; ok && o != owner {
+		return false
+	}
+	if !portFree(port) {
+		return false
+	}
+	pa.used[port] = owner
+	return true
+}
+
+// allocate returns a free port in [r] for [owner], or any free port
+// if [r] is nil.
+func (pa *portAllocator) allocate(owner portOwner, r *rpcpb.PortRange) (uint16, error) {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+
+	if r == nil {
+		for i := 0; i < maxPortAttempts; i++ {
+			port, err := freePort()
+			if err != nil {
+				return 0, err
+			}
+			if _, ok := pa.used[port]; !ok {
+				pa.used[port] = owner
+				return port, nil
+			}
+		}
+		return 0, ErrNoFreePort
+	}
+
+	end := r.End
+	if end == 0 {
+		end = math.MaxUint16
+	}
+	for p := r.Start; p <= end; p++ {
+		port := uint16(p)
+		if _, ok := pa.used[port]; ok {
+			continue
+		}
+		if portFree(port) {
+			pa.used[port] = owner
+			return port, nil
+		}
+	}
+	return 0, fmt.Errorf("%w in [%d, %d]", ErrNoFreePort, r.Start, end)
+}
+
+// release frees the ports of the node, or of all nodes in the cluster
+// if [node] is empty.
+func (pa *portAllocator) release(cluster string, node string) {
+	pa.mu.Lock()
+	defer pa.mu.Unlock()
+	for port, o := range pa.used {
+		if o.cluster == cluster && (node == "" || o.node == node) {
+			delete(pa.used, port)
+		}
+	}
+}
+
+func portFree(port uint16) bool {
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	ln.Close()
+	return true
+}
+
+func freePort() (uint16, error) {
+	ln, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return 0, err
+	}
+	defer ln.Close()
+	return uint16(ln.Addr().(*net.TCPAddr).Port), nil
+}
+
+// assignPorts sets the API and staking ports of the node in [configFile],
+// right before launching it. The ports in the config are kept if still
+// free, and the others are allocated from the cluster port range. The
+// staking port of a relaunched beacon is kept, since the other nodes
+// bootstrap from it.
+func (lc *localNetwork) assignPorts(name string, configFile []byte, beacon bool) ([]byte, error) {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(configFile, &m); err != nil {
+		return nil, err
+	}
+	owner := portOwner{cluster: lc.clusterName, node: name}
+	lc.ports.release(owner.cluster, owner.node)
+	for _, key := range []string{httpPortKey, stakingPortKey} {
+		if v, ok := m[key].(float64); ok {
+			if lc.ports.claim(owner, uint16(v)) {
+				continue
+			}
+			if beacon && key == stakingPortKey {
+				lc.ports.release(owner.cluster, owner.node)
+				return nil, fmt.Errorf("%w: beacon node %q %s %d is in use", ErrNoFreePort, name, key, uint16(v))
+			}
+			color.Outf("{{yellow}}node %q %s %d is in use, reallocating{{/}}\n", name, key, uint16(v))
+		}
+		port, err := lc.ports.allocate(owner, lc.portRange)
+		if err != nil {
+			lc.ports.release(owner.cluster, owner.node)
+			return nil, fmt.Errorf("node %q %s: %w", name, key, err)
+		}
+		m[key] = port
+	}
+	return json.Marshal(m)
+}
+
+// reassignPorts allocates new API and staking ports to the node, in place
+// of the ones in [configFile] that another process took. A beacon keeps
+// its staking port.
+func (lc *localNetwork) reassignPorts(name string, configFile []byte, beacon bool) ([]byte, error) {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(configFile, &m); err != nil {
+		return nil, err
+	}
+	delete(m, httpPortKey)
+	if !beacon {
+		delete(m, stakingPortKey)
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return lc.assignPorts(name, b, beacon)
+}
+
+// nodeLaunch is a node launched by the runner, which is bound once its
+// API serves the node ID.
+type nodeLaunch struct {
+	cfg  *node.Config
+	info *rpcpb.NodeInfo
+}
+
+// newLaunch returns the launch of the node in [cfg], right before
+// the runner starts it. [cfg] and [info] are updated on relaunches.
+func newLaunch(cfg *node.Config, info *rpcpb.NodeInfo) *nodeLaunch {
+	return &nodeLaunch{cfg: cfg, info: info}
+}
+
+// bindState returns whether the node bound its ports, and whether it
+// exited without binding them while one of them is in use.
+func (l *nodeLaunch) bindState() (bound bool, inUse bool, exited bool) {
+	if l.serving() {
+		return true, false, false
+	}
+	exited, err := nodeExited(l.info.DbDir)
+	if err != nil || !exited {
+		return false, false, false
+	}
+	inUse = !portFree(uint16(l.info.ApiPort)) || !portFree(uint16(l.info.StakingPort))
+	return false, inUse, true
+}
+
+// serving returns true if the API port serves the ID of the node, as
+// avalanchego binds the staking port before the API port.
+func (l *nodeLaunch) serving() bool {
+	nodeID, err := utils.ToNodeID(l.cfg.StakingKey, l.cfg.StakingCert)
+	if err != nil {
+		return false
+	}
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(l.cfg.ConfigFile, &m); err != nil {
+		return false
+	}
+	scheme := "http"
+	if tls, _ := m[httpTLSEnabledKey].(bool); tls {
+		scheme = "https"
+	}
+	req, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "info.getNodeID",
+		"params":  struct{}{},
+	})
+	if err != nil {
+		return false
+	}
+	url := fmt.Sprintf("%s://127.0.0.1:%d/ext/info", scheme, l.info.ApiPort)
+	resp, err := portBindClient.Post(url, "application/json", bytes.NewReader(req))
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	var reply struct {
+		Result struct {
+			NodeID string `json:"nodeID"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return false
+	}
+	return reply.Result.NodeID == nodeID.PrefixedString(constants.NodeIDPrefix)
+}
+
+// nodeExited returns true if the node process with [dbDir] is gone,
+// or exited and not waited for yet.
+func nodeExited(dbDir string) (bool, error) {
+	pid, err := nodePID(dbDir)
+	if errors.Is(err, ErrProcessNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	exited, _, err := processExited(pid)
+	return exited, err
+}
+
+// waitForBind polls the launched nodes until each of them bound its ports
+// or exited, for up to [portBindWait], and returns the ones that exited
+// with a port in use. The others are left to the health checks.
+func (lc *localNetwork) waitForBind(launches []*nodeLaunch) ([]*nodeLaunch, error) {
+	deadline := time.Now().Add(portBindWait)
+	pending := launches
+	var inUse []*nodeLaunch
+	for {
+		var next []*nodeLaunch
+		for _, l := range pending {
+			bound, conflict, exited := l.bindState()
+			switch {
+			case conflict:
+				inUse = append(inUse, l)
+			case !bound && !exited:
+				next = append(next, l)
+			}
+		}
+		pending = next
+		if len(pending) == 0 || time.Now().After(deadline) {
+			return inUse, nil
+		}
+		select {
+		case <-lc.stopc:
+			return nil, errAborted
+		case <-time.After(portBindPollInterval):
+		}
+	}
+}
+
+// retryPortConflicts relaunches the launched nodes that fail to bind their
+// ports, because another process took them after the allocation, with newly
+// allocated ports, up to [maxLaunchAttempts] launches each.
+func (lc *localNetwork) retryPortConflicts(launches []*nodeLaunch) error {
+	for attempt := 1; ; attempt++ {
+		inUse, err := lc.waitForBind(launches)
+		if err != nil {
+			return err
+		}
+		if len(inUse) == 0 {
+			return nil
+		}
+		if attempt == maxLaunchAttempts {
+			return fmt.Errorf("%w: node %q ports in use after %d launches", ErrNoFreePort, inUse[0].cfg.Name, attempt)
+		}
+		for _, l := range inUse {
+			name := l.cfg.Name
+			color.Outf("{{yellow}}node %q failed to bind its ports, relaunching with new ports{{/}}\n", name)
+			if err := lc.removeRunnerNode(name); err != nil {
+				return err
+			}
+			configFile, err := lc.reassignPorts(name, l.cfg.ConfigFile, l.cfg.IsBeacon)
+			if err != nil {
+				return err
+			}
+			l.cfg.ConfigFile = configFile
+			if err := updateNodeInfo(l.info, configFile); err != nil {
+				return err
+			}
+			if _, err := lc.nw.AddNode(*l.cfg); err != nil {
+				return err
+			}
+		}
+		launches = inUse
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/gyuho/avax-tester/rpcpb"
+)
+
+func TestCheckPortRange(t *testing.T) {
+	tests := []struct {
+		r     *rpcpb.PortRange
+		valid bool
+	}{
+		{r: nil, valid: true},
+		{r: &rpcpb.PortRange{Start: 9650}, valid: true},
+		{r: &rpcpb.PortRange{Start: 9650, End: 9650}, valid: true},
+		{r: &rpcpb.PortRange{Start: 9650, End: 9700}, valid: true},
+		{r: &rpcpb.PortRange{}, valid: false},
+		{r: &rpcpb.PortRange{Start: 9650, End: 9649}, valid: false},
+		{r: &rpcpb.PortRange{Start: 70000}, valid: false},
+		{r: &rpcpb.PortRange{Start: 9650, End: 70000}, valid: false},
+	}
+	for i, tv := range tests {
+		err := checkPortRange(tv.r)
+		if tv.valid && err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		if !tv.valid && !errors.Is(err, ErrInvalidPortRange) {
+			t.Fatalf("#%d: expected %v, got %v", i, ErrInvalidPortRange, err)
+		}
+	}
+}
+
+// listenRange returns a range of [n] ports free when called.
+func listenRange(t *testing.T, n int) *rpcpb.PortRange {
+	t.Helper()
+	for i := 0; i < maxPortAttempts; i++ {
+		start, err := freePort()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int(start)+n > 65535 {
+			continue
+		}
+		free := true
+		for p := start; p < start+uint16(n); p++ {
+			free = free && portFree(p)
+		}
+		if free {
+			return &rpcpb.PortRange{Start: uint32(start), End: uint32(start) + uint32(n) - 1}
+		}
+	}
+	t.Skip("no free port range")
+	return nil
+}
+
+func TestAllocateRange(t *testing.T) {
+	r := listenRange(t, 4)
+	pa := newPortAllocator()
+	node1 := portOwner{cluster: "c", node: "node1"}
+	node2 := portOwner{cluster: "c", node: "node2"}
+
+	port, err := pa.allocate(node1, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uint32(port) != r.Start {
+		t.Fatalf("expected %d, got %d", r.Start, port)
+	}
+
+	// allocated and busy ports are skipped
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", r.Start+1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	port, err = pa.allocate(node2, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uint32(port) != r.Start+2 {
+		t.Fatalf("expected %d, got %d", r.Start+2, port)
+	}
+	if _, err := pa.allocate(node2, r); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pa.allocate(node2, r); !errors.Is(err, ErrNoFreePort) {
+		t.Fatalf("expected %v, got %v", ErrNoFreePort, err)
+	}
+
+	// released ports are allocated again
+	pa.release("c", "node1")
+	port, err = pa.allocate(node2, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uint32(port) != r.Start {
+		t.Fatalf("expected %d, got %d", r.Start, port)
+	}
+	pa.release("c", "")
+	if len(pa.used) != 0 {
+		t.Fatalf("ports not released %v", pa.used)
+	}
+}
+
+func TestAllocateAny(t *testing.T) {
+	pa := newPortAllocator()
+	owner := portOwner{cluster: "c", node: "node1"}
+	seen := make(map[uint16]bool)
+	for i := 0; i < 5; i++ {
+		port, err := pa.allocate(owner, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[port] {
+			t.Fatalf("port %d allocated twice", port)
+		}
+		seen[port] = true
+	}
+}
+
+func TestClaim(t *testing.T) {
+	r := listenRange(t, 2)
+	pa := newPortAllocator()
+	node1 := portOwner{cluster: "c1", node: "node1"}
+	node2 := portOwner{cluster: "c2", node: "node1"}
+	port := uint16(r.Start)
+
+	if !pa.claim(node1, port) {
+		t.Fatal("free port not claimed")
+	}
+	if !pa.claim(node1, port) {
+		t.Fatal("port not claimed again by its owner")
+	}
+	if pa.claim(node2, port) {
+		t.Fatal("port claimed by another node")
+	}
+
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port+1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	if pa.claim(node1, port+1) {
+		t.Fatal("busy port claimed")
+	}
+}
+
+func TestBindState(t *testing.T) {
+	lc := newTestNetwork(t, newFakeNode(t), 1, nil)
+	name := lc.nodeNames[0]
+	launches := assignTestPorts(t, lc)
+	l := launches[0]
+
+	// not launched yet
+	if bound, inUse, exited := l.bindState(); bound || inUse || !exited {
+		t.Fatalf("unexpected bind state %v, %v, %v", bound, inUse, exited)
+	}
+	ln := listenPort(t, lc.nodeInfos[name].ApiPort)
+	if bound, inUse, exited := l.bindState(); bound || !inUse || !exited {
+		t.Fatalf("unexpected bind state %v, %v, %v", bound, inUse, exited)
+	}
+	ln.Close()
+
+	nw, err := local.NewNetwork(lc.logger, lc.cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = nw.Stop(context.Background())
+	})
+	deadline := time.Now().Add(portBindWait)
+	for {
+		bound, inUse, exited := l.bindState()
+		if bound {
+			break
+		}
+		if inUse || exited || time.Now().After(deadline) {
+			t.Fatalf("unexpected bind state %v, %v, %v", bound, inUse, exited)
+		}
+		time.Sleep(portBindPollInterval)
+	}
+}
+
+// listenPort takes [port] from the nodes, until closed.
+func listenPort(t *testing.T, port uint32) net.Listener {
+	t.Helper()
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		ln.Close()
+	})
+	return ln
+}
+
+// conflictNetwork takes the API port of the first [conflicts] launches
+// of [node], right before launching it, as another process would.
+type conflictNetwork struct {
+	network.Network
+	t         *testing.T
+	node      string
+	conflicts int
+	// ports of each launch of [node]
+	launches []*rpcpb.NodeInfo
+}
+
+func (n *conflictNetwork) launch(cfg node.Config) {
+	if cfg.Name != n.node {
+		return
+	}
+	info := &rpcpb.NodeInfo{}
+	if err := updateNodeInfo(info, cfg.ConfigFile); err != nil {
+		n.t.Fatal(err)
+	}
+	n.launches = append(n.launches, info)
+	if len(n.launches) <= n.conflicts {
+		listenPort(n.t, info.ApiPort)
+	}
+}
+
+func (n *conflictNetwork) AddNode(cfg node.Config) (node.Node, error) {
+	n.launch(cfg)
+	return n.Network.AddNode(cfg)
+}
+
+// assignTestPorts assigns the ports of the nodes of [lc] as in start,
+// and returns their launches.
+func assignTestPorts(t *testing.T, lc *localNetwork) []*nodeLaunch {
+	t.Helper()
+	launches := make([]*nodeLaunch, len(lc.cfg.NodeConfigs))
+	for i, cfg := range lc.cfg.NodeConfigs {
+		configFile, err := lc.assignPorts(cfg.Name, cfg.ConfigFile, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lc.cfg.NodeConfigs[i].ConfigFile = configFile
+		if err := updateNodeInfo(lc.nodeInfos[cfg.Name], configFile); err != nil {
+			t.Fatal(err)
+		}
+		launches[i] = newLaunch(&lc.cfg.NodeConfigs[i], lc.nodeInfos[cfg.Name])
+	}
+	return launches
+}
+
+// launchTestNetwork starts the nodes of [lc] as in start, with the API
+// port of the first [conflicts] launches of the last node in use, and
+// returns the result of the port conflict retries.
+func launchTestNetwork(t *testing.T, lc *localNetwork, conflicts int) (*conflictNetwork, error) {
+	t.Helper()
+	launches := assignTestPorts(t, lc)
+	cn := &conflictNetwork{t: t, node: lc.nodeNames[len(lc.nodeNames)-1], conflicts: conflicts}
+	for _, l := range launches {
+		cn.launch(*l.cfg)
+	}
+	nw, err := local.NewNetwork(lc.logger, lc.cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cn.Network = nw
+	lc.nw = cn
+	t.Cleanup(func() {
+		_ = nw.Stop(context.Background())
+	})
+	return cn, lc.retryPortConflicts(launches)
+}
+
+func TestRetryPortConflicts(t *testing.T) {
+	for _, beacon := range []bool{true, false} {
+		// the runner requires a beacon
+		lc := newTestNetwork(t, newFakeNode(t), 2, nil)
+		lc.cfg.NodeConfigs[1].IsBeacon = beacon
+		name := lc.nodeNames[1]
+		cn, err := launchTestNetwork(t, lc, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cn.launches) != 2 {
+			t.Fatalf("expected 2 launches, got %d", len(cn.launches))
+		}
+		runnerNode, err := lc.nw.GetNode(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// relaunched with a new API port, while a beacon keeps its staking
+		// port that the other nodes bootstrap from
+		first, info := cn.launches[0], lc.nodeInfos[name]
+		if info.ApiPort == first.ApiPort || uint32(runnerNode.GetAPIPort()) != info.ApiPort {
+			t.Fatalf("API port %d not reassigned (first launch %d)", info.ApiPort, first.ApiPort)
+		}
+		if beacon != (info.StakingPort == first.StakingPort) {
+			t.Fatalf("beacon %v: unexpected staking port %d (first launch %d)", beacon, info.StakingPort, first.StakingPort)
+		}
+		for _, port := range []uint32{info.ApiPort, info.StakingPort} {
+			if o, ok := lc.ports.used[uint16(port)]; !ok || o.node != name {
+				t.Fatalf("port %d not allocated to %q", port, name)
+			}
+		}
+	}
+}
+
+func TestRetryPortConflictsGivesUp(t *testing.T) {
+	lc := newTestNetwork(t, newFakeNode(t), 1, nil)
+	cn, err := launchTestNetwork(t, lc, maxLaunchAttempts)
+	if !errors.Is(err, ErrNoFreePort) {
+		t.Fatalf("expected %v, got %v", ErrNoFreePort, err)
+	}
+	if len(cn.launches) != maxLaunchAttempts {
+		t.Fatalf("expected %d launches, got %d", maxLaunchAttempts, len(cn.launches))
+	}
+}
+
+func TestRetryPortConflictsBeaconStakingPort(t *testing.T) {
+	lc := newTestNetwork(t, newFakeNode(t), 1, nil)
+	lc.cfg.NodeConfigs[0].IsBeacon = true
+	name := lc.nodeNames[0]
+	launches := assignTestPorts(t, lc)
+
+	// the staking port of a relaunched beacon is not reallocated
+	listenPort(t, lc.nodeInfos[name].StakingPort)
+	if _, err := lc.reassignPorts(name, launches[0].cfg.ConfigFile, true); !errors.Is(err, ErrNoFreePort) {
+		t.Fatalf("expected %v, got %v", ErrNoFreePort, err)
+	}
+	if len(lc.ports.used) != 0 {
+		t.Fatalf("ports not released %v", lc.ports.used)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/gyuho/avax-tester/pkg/color"
+	"github.com/gyuho/avax-tester/rpcpb"
+)
+
+var (
+	ErrProcessNotFound = errors.New("node process not found")
+	ErrNodePaused      = errors.New("node paused")
+	ErrNodeNotPaused   = errors.New("node not paused")
+)
+
+// nodePID returns the PID of the avalanchego process that runs with
+// [dbDir], which is unique per node. The runner does not expose its
+// processes, so this looks it up by the command line.
+func nodePID(dbDir string) (int, error) {
+	out, err := exec.Command("ps", "-axww", "-o", "pid=,args=").Output()
+	if err != nil {
+		return 0, err
+	}
+	flag := "--db-dir=" + dbDir
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		for _, arg := range fields[1:] {
+			if arg != flag {
+				continue
+			}
+			return strconv.Atoi(fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, ErrProcessNotFound
+}
+
+// processExited returns true if the process exited, including a zombie
+// that the runner has not waited for yet, with its exit code (-1 if
+// killed by a signal, or unknown).
+func processExited(pid int) (bool, int32, error) {
+	b, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err == nil {
+		return parseProcStat(string(b))
+	}
+	if _, serr := os.Stat("/proc/self/stat"); serr == nil {
+		// procfs available, but the process is gone
+		return true, -1, nil
+	}
+
+	// no procfs (e.g., macOS)
+	out, err := exec.Command("ps", "-o", "stat=", "-p", strconv.Itoa(pid)).Output()
+	st := strings.TrimSpace(string(out))
+	if err != nil && st == "" {
+		return true, -1, nil
+	}
+	return strings.HasPrefix(st, "Z"), -1, nil
+}
+
+// parseProcStat parses "/proc/[pid]/stat", where the exit status is
+// the 52nd field (since Linux 3.5). See proc(5).
+func parseProcStat(stat string) (bool, int32, error) {
+	// the command name in parentheses may contain spaces
+	idx := strings.LastIndex(stat, ")")
+	if idx < 0 {
+		return false, 0, fmt.Errorf("unexpected /proc stat %q", stat)
+	}
+	fields := strings.Fields(stat[idx+1:])
+	if len(fields) == 0 {
+		return false, 0, fmt.Errorf("unexpected /proc stat %q", stat)
+	}
+	if fields[0] != "Z" && fields[0] != "X" {
+		return false, 0, nil
+	}
+	if len(fields) < 50 {
+		return true, -1, nil
+	}
+	v, err := strconv.Atoi(fields[49])
+	if err != nil {
+		return true, -1, nil
+	}
+	ws := syscall.WaitStatus(v)
+	if !ws.Exited() {
+		return true, -1, nil
+	}
+	return true, int32(ws.ExitStatus()), nil
+}
+
+// pauseNode freezes the process of the node with SIGSTOP, keeping it
+// in the network along with its in-memory state.
+func (lc *localNetwork) pauseNode(name string) error {
+	info, ok := lc.nodeInfos[name]
+	if !ok {
+		return ErrNodeNotFound
+	}
+	if info.Paused {
+		return ErrNodePaused
+	}
+	pid, err := nodePID(info.DbDir)
+	if err != nil {
+		return err
+	}
+	color.Outf("{{yellow}}{{bold}}pausing node %q (PID %d){{/}}\n", name, pid)
+	if err := syscall.Kill(pid, syscall.SIGSTOP); err != nil {
+		return err
+	}
+	info.Paused = true
+	lc.monitor.setPaused(name, true)
+	lc.emit(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_PAUSED, NodeName: name})
+	return nil
+}
+
+// resumeNode continues the process of the paused node with SIGCONT.
+func (lc *localNetwork) resumeNode(name string) error {
+	info, ok := lc.nodeInfos[name]
+	if !ok {
+		return ErrNodeNotFound
+	}
+	if !info.Paused {
+		return ErrNodeNotPaused
+	}
+	pid, err := nodePID(info.DbDir)
+	if err != nil {
+		return err
+	}
+	color.Outf("{{blue}}{{bold}}resuming node %q (PID %d){{/}}\n", name, pid)
+	if err := syscall.Kill(pid, syscall.SIGCONT); err != nil {
+		return err
+	}
+	info.Paused = false
+	lc.monitor.setPaused(name, false)
+	lc.emit(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_RESUMED, NodeName: name})
+	return nil
+}
+
+// resumePausedNodes resumes all paused nodes, since a stopped process
+// does not handle SIGTERM until continued.
+func (lc *localNetwork) resumePausedNodes() {
+	for name, info := range lc.nodeInfos {
+		if !info.Paused {
+			continue
+		}
+		if err := lc.resumeNode(name); err != nil {
+			color.Outf("{{red}}failed to resume node %q:{{/}} %v\n", name, err)
+		}
+	}
+}
@@ -13,26 +13,32 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/ava-labs/avalanche-network-runner/local"
-	"github.com/ava-labs/avalanche-network-runner/network/node"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/gyuho/avax-tester/rpcpb"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type Config struct {
-	Port        string
-	GwPort      string
+	Port   string
+	GwPort string
+	// serves "/metrics" on a separate port if not empty,
+	// on the gRPC gateway port otherwise
+	MetricsPort string
 	DialTimeout time.Duration
+	// defaults to "$HOME/.avalanche-network-runner/snapshots"
+	SnapshotsDir string
 }
 
 type Server interface {
@@ -53,9 +59,17 @@ type server struct {
 	gwMux    *runtime.ServeMux
 	gwServer *http.Server
 
-	mu          sync.RWMutex
-	clusterInfo *rpcpb.ClusterInfo
-	network     *localNetwork
+	metrics       *metrics
+	metricsLn     net.Listener
+	metricsServer *http.Server
+
+	// protects "clusters"
+	mu       sync.RWMutex
+	clusters map[string]*cluster
+
+	ops    *operations
+	events *events
+	ports  *portAllocator
 
 	rpcpb.UnimplementedPingServiceServer
 	rpcpb.UnimplementedControlServiceServer
@@ -72,25 +86,59 @@ func New(cfg Config) (Server, error) {
 		return nil, ErrInvalidPort
 	}
 
+	if cfg.SnapshotsDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		cfg.SnapshotsDir = filepath.Join(homeDir, ".avalanche-network-runner", "snapshots")
+	}
+
 	ln, err := net.Listen("tcp", cfg.Port)
 	if err != nil {
 		return nil, err
 	}
-	gwMux := runtime.NewServeMux()
-	return &server{
+	s := &server{
 		cfg: cfg,
 
 		closed: make(chan struct{}),
 
-		ln:         ln,
-		gRPCServer: grpc.NewServer(),
+		ln: ln,
 
-		gwMux: gwMux,
-		gwServer: &http.Server{
-			Addr:    cfg.GwPort,
-			Handler: gwMux,
-		},
-	}, nil
+		clusters: make(map[string]*cluster),
+		ops:      newOperations(),
+		events:   newEvents(),
+		ports:    newPortAllocator(),
+
+		gwMux: runtime.NewServeMux(),
+	}
+	s.metrics = newMetrics(s)
+	s.gRPCServer = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(s.metrics.unaryInterceptor, unaryStatusInterceptor),
+		grpc.ChainStreamInterceptor(s.metrics.streamInterceptor, streamStatusInterceptor),
+	)
+
+	metricsHandler := promhttp.HandlerFor(s.metrics.registry, promhttp.HandlerOpts{})
+	gwHandler := http.NewServeMux()
+	gwHandler.Handle("/", s.gwMux)
+	gwHandler.HandleFunc("/v1/metrics/nodes", s.serveNodeMetrics)
+	if cfg.MetricsPort == "" {
+		gwHandler.Handle("/metrics", metricsHandler)
+	} else {
+		s.metricsLn, err = net.Listen("tcp", cfg.MetricsPort)
+		if err != nil {
+			ln.Close()
+			return nil, err
+		}
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", metricsHandler)
+		s.metricsServer = &http.Server{Handler: metricsMux}
+	}
+	s.gwServer = &http.Server{
+		Addr:    cfg.GwPort,
+		Handler: gwHandler,
+	}
+	return s, nil
 }
 
 func (s *server) Run(rootCtx context.Context) (err error) {
@@ -136,6 +184,18 @@ func (s *server) Run(rootCtx context.Context) (err error) {
 		gwErrc <- s.gwServer.ListenAndServe()
 	}()
 
+	if s.metricsServer != nil {
+		go func() {
+			zap.L().Info("serving metrics", zap.String("port", s.cfg.MetricsPort))
+			if err := s.metricsServer.Serve(s.metricsLn); err != http.ErrServerClosed {
+				zap.L().Warn("metrics server failed", zap.Error(err))
+			}
+		}()
+		defer func() {
+			zap.L().Warn("closed metrics server", zap.Error(s.metricsServer.Close()))
+		}()
+	}
+
 	select {
 	case <-rootCtx.Done():
 		zap.L().Warn("root context is done")
@@ -168,6 +228,7 @@ func (s *server) Run(rootCtx context.Context) (err error) {
 var (
 	ErrAlreadyBootstrapped = errors.New("already bootstrapped")
 	ErrNotBootstrapped     = errors.New("not bootstrapped")
+	ErrNotHealthy          = errors.New("not healthy")
 	ErrNodeNotFound        = errors.New("node not found")
 	ErrUnexpectedType      = errors.New("unexpected type")
 	ErrStatusCanceled      = errors.New("gRPC stream status canceled")
@@ -179,93 +240,241 @@ func (s *server) Ping(ctx context.Context, req *rpcpb.PingRequest) (*rpcpb.PingR
 }
 
 func (s *server) Start(ctx context.Context, req *rpcpb.StartRequest) (*rpcpb.StartResponse, error) {
-	zap.L().Info("received start request")
-	if s.getClusterInfo() != nil {
-		return nil, ErrAlreadyBootstrapped
+	start := time.Now()
+	c, err := s.start(req)
+	if err != nil {
+		return nil, err
+	}
+	// canceling the operation rolls back the cluster
+	op := s.ops.run(s.rootCtx, "Start", c, func(ctx context.Context, _ *operation) error {
+		err := s.waitForCluster(ctx, c)
+		s.metrics.observeHealthy("Start", start, err)
+		return err
+	})
+	if req.GetWaitForHealthy() {
+		zap.L().Info("waiting for healthy", zap.String("cluster", c.name))
+		done, err := s.ops.wait(ctx, op.Id)
+		if ctx.Err() != nil {
+			// the request is done before the cluster is healthy
+			if _, cerr := s.ops.cancel(context.Background(), op.Id); cerr != nil {
+				zap.L().Warn("failed to cancel start", zap.Error(cerr))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		op = done
 	}
+	return &rpcpb.StartResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
 
-	rootDataDir, err := ioutil.TempDir(os.TempDir(), "network-runner-root-data")
+func (s *server) start(req *rpcpb.StartRequest) (*cluster, error) {
+	name, err := checkClusterName(req.GetClusterName())
 	if err != nil {
 		return nil, err
 	}
+	zap.L().Info("received start request", zap.String("cluster", name))
+	if _, err := os.Stat(req.ExecPath); err != nil {
+		return nil, ErrNotExists
+	}
 
-	info := &rpcpb.ClusterInfo{
-		Pid:         int32(os.Getpid()),
-		RootDataDir: rootDataDir,
-		Healthy:     false,
+	c, err := s.reserveCluster(name)
+	if err != nil {
+		return nil, err
+	}
+	defer c.mu.Unlock()
+
+	info, err := newClusterInfo(name)
+	if err != nil {
+		s.deleteCluster(name)
+		return nil, err
 	}
 	zap.L().Info("starting",
+		zap.String("cluster", name),
 		zap.String("execPath", req.ExecPath),
+		zap.Uint32("numNodes", req.GetNumberOfNodes()),
+		zap.Int("nodeSpecs", len(req.GetNodeSpecs())),
 		zap.String("whitelistedSubnets", req.GetWhitelistedSubnets()),
-		zap.Int32("pid", s.clusterInfo.GetPid()),
-		zap.String("rootDataDir", s.clusterInfo.GetRootDataDir()),
+		zap.Int32("pid", info.GetPid()),
+		zap.String("rootDataDir", info.GetRootDataDir()),
 	)
-	if _, err := os.Stat(req.ExecPath); err != nil {
-		return nil, ErrNotExists
+
+	c.network, err = newNetwork(localNetworkOptions{
+		execPath:           req.GetExecPath(),
+		rootDataDir:        info.RootDataDir,
+		numNodes:           req.GetNumberOfNodes(),
+		nodeSpecs:          req.GetNodeSpecs(),
+		whitelistedSubnets: req.GetWhitelistedSubnets(),
+		logLevel:           req.GetLogLevel(),
+		globalNodeConfig:   req.GetGlobalNodeConfig(),
+		genesis:            req.GetGenesis(),
+		genesisParams:      req.GetGenesisParams(),
+		restartPolicy:      req.GetRestartPolicy(),
+		portRange:          req.GetPortRange(),
+		chainConfigs:       req.GetChainConfigs(),
+		ports:              s.ports,
+		clusterName:        name,
+		events:             s.events,
+	})
+	if err != nil {
+		s.deleteCluster(name)
+		os.RemoveAll(info.RootDataDir)
+		return nil, err
 	}
+	s.runCluster(c, info)
+	return c, nil
+}
 
+// reserveCluster registers a new cluster of the name, so that concurrent
+// starts of the same cluster fail. The returned cluster is locked.
+func (s *server) reserveCluster(name string) (*cluster, error) {
+	c := newCluster(name)
+	c.mu.Lock()
 	s.mu.Lock()
 	defer s.mu.Unlock()
-
-	if s.network != nil {
+	if _, ok := s.clusters[name]; ok {
+		c.mu.Unlock()
 		return nil, ErrAlreadyBootstrapped
 	}
+	s.clusters[name] = c
+	return c, nil
+}
 
-	s.network, err = newNetwork(req.GetExecPath(), rootDataDir, req.GetWhitelistedSubnets(), req.GetLogLevel())
+func newClusterInfo(name string) (*rpcpb.ClusterInfo, error) {
+	rootDataDir, err := ioutil.TempDir(os.TempDir(), "network-runner-root-data-"+name+"-")
 	if err != nil {
 		return nil, err
 	}
-	go s.network.start()
+	return &rpcpb.ClusterInfo{
+		Name:        name,
+		Pid:         int32(os.Getpid()),
+		RootDataDir: rootDataDir,
+		Healthy:     false,
+	}, nil
+}
+
+// runCluster starts the network of the locked cluster [c], and reports
+// the nodes in [info], which is marked healthy once the nodes are. If the
+// network fails to start, the cluster is rolled back.
+func (s *server) runCluster(c *cluster, info *rpcpb.ClusterInfo) {
+	nw := c.network
+	s.events.publish(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_CLUSTER_STARTING, ClusterName: c.name})
+	nw.monitor.onUpdate = func() {
+		c.refresh(nw)
+	}
+	nw.supervisor.restart = func(name string) {
+		s.ops.run(s.rootCtx, "AutoRestart", c, func(ctx context.Context, _ *operation) error {
+			nw, err := c.lockStartedNetwork(ctx)
+			if err != nil {
+				return err
+			}
+			defer c.unlockNetwork()
+			err = nw.autoRestart(name)
+			c.publish(nw)
+			return err
+		})
+	}
+	c.info = info
+	// the nodes are listed before they are healthy (e.g., for their logs)
+	c.publishLocked(nw)
+	go nw.start()
 
-	s.clusterInfo = info
 	go func() {
+		var err error
 		select {
 		case <-s.closed:
-			return
-		case <-s.network.stopc:
+			err = ErrClosed
+		case <-nw.stopc:
 			// TODO: fix race from shutdown
-			return
-		case <-s.network.readyc:
-			s.mu.Lock()
-			s.clusterInfo.NodeNames = s.network.nodeNames
-			s.clusterInfo.NodeInfos = s.network.nodeInfos
-			s.clusterInfo.Healthy = true
-			s.mu.Unlock()
+			err = errAborted
+		case err = <-nw.errc:
+			if errors.Is(err, errAborted) {
+				// being stopped
+				break
+			}
+			zap.L().Warn("failed to start cluster", zap.String("cluster", c.name), zap.Error(err))
+			s.rollbackCluster(c, nw)
+		case <-nw.readyc:
+			c.publish(nw)
+			s.events.publish(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_CLUSTER_HEALTHY, ClusterName: c.name})
 		}
+		c.startErr = err
+		close(c.startedc)
 	}()
-	return &rpcpb.StartResponse{ClusterInfo: s.clusterInfo}, nil
 }
 
-func (s *server) Health(ctx context.Context, req *rpcpb.HealthRequest) (*rpcpb.HealthResponse, error) {
-	zap.L().Debug("health")
-	if info := s.getClusterInfo(); info == nil {
-		return nil, ErrNotBootstrapped
+// waitForCluster waits until the cluster is healthy. If the cluster
+// fails to start or [ctx] is done first, the cluster is rolled back.
+func (s *server) waitForCluster(ctx context.Context, c *cluster) error {
+	select {
+	case <-c.startedc:
+		return c.startErr
+	case <-ctx.Done():
 	}
-
-	zap.L().Info("waiting for healthy")
-	if err := s.network.waitForHealthy(); err != nil {
-		return nil, err
+	select {
+	case <-c.startedc:
+		return c.startErr
+	default:
+	}
+	c.mu.RLock()
+	nw := c.network
+	c.mu.RUnlock()
+	if nw != nil {
+		s.rollbackCluster(c, nw)
 	}
+	return ctx.Err()
+}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// rollbackCluster kills the node processes of [nw], removes its root
+// data directory, and removes the cluster, so that it can be started again.
+// No-op if the cluster no longer runs [nw].
+func (s *server) rollbackCluster(c *cluster, nw *localNetwork) {
+	c.mu.Lock()
+	if c.network != nw {
+		c.mu.Unlock()
+		return
+	}
+	c.network = nil
+	c.info = nil
+	c.mu.Unlock()
+	s.deleteCluster(c.name)
+
+	zap.L().Warn("rolling back cluster", zap.String("cluster", c.name), zap.String("rootDataDir", nw.rootDataDir))
+	nw.stop()
+	if err := os.RemoveAll(nw.rootDataDir); err != nil {
+		zap.L().Warn("failed to remove root data dir", zap.Error(err))
+	}
+	s.events.publish(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_CLUSTER_FAILED, ClusterName: c.name})
+}
 
-	s.network.nodeNames = make([]string, 0)
-	for name := range s.network.nodeInfos {
-		s.network.nodeNames = append(s.network.nodeNames, name)
+func (s *server) Health(ctx context.Context, req *rpcpb.HealthRequest) (*rpcpb.HealthResponse, error) {
+	zap.L().Debug("health", zap.String("cluster", req.GetClusterName()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	nw, err := c.lockStartedNetwork(ctx)
+	if err != nil {
+		return nil, err
 	}
-	s.clusterInfo.NodeNames = s.network.nodeNames
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	defer c.unlockNetwork()
 
-	return &rpcpb.HealthResponse{ClusterInfo: s.clusterInfo}, nil
+	zap.L().Info("waiting for healthy")
+	err = nw.waitForHealthy(ctx)
+	c.publish(nw)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.HealthResponse{ClusterInfo: c.getInfo()}, nil
 }
 
 func (s *server) URIs(ctx context.Context, req *rpcpb.URIsRequest) (*rpcpb.URIsResponse, error) {
-	zap.L().Debug("uris")
-	info := s.getClusterInfo()
-	if info == nil {
-		return nil, ErrNotBootstrapped
+	zap.L().Debug("uris", zap.String("cluster", req.GetClusterName()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
 	}
+	info := c.getInfo()
 	uris := make([]string, 0, len(info.NodeInfos))
 	for _, i := range info.NodeInfos {
 		uris = append(uris, i.Uri)
@@ -275,18 +484,45 @@ func (s *server) URIs(ctx context.Context, req *rpcpb.URIsRequest) (*rpcpb.URIsR
 }
 
 func (s *server) Status(ctx context.Context, req *rpcpb.StatusRequest) (*rpcpb.StatusResponse, error) {
-	zap.L().Debug("received status request")
-	info := s.getClusterInfo()
-	if info == nil {
-		return nil, ErrNotBootstrapped
+	zap.L().Debug("received status request", zap.String("cluster", req.GetClusterName()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
 	}
-	return &rpcpb.StatusResponse{ClusterInfo: info}, nil
+	return &rpcpb.StatusResponse{ClusterInfo: c.getInfo()}, nil
+}
+
+func (s *server) ListClusters(ctx context.Context, req *rpcpb.ListClustersRequest) (*rpcpb.ListClustersResponse, error) {
+	zap.L().Debug("received list clusters request")
+	s.mu.RLock()
+	clusters := make([]*cluster, 0, len(s.clusters))
+	for _, c := range s.clusters {
+		clusters = append(clusters, c)
+	}
+	s.mu.RUnlock()
+
+	resp := &rpcpb.ListClustersResponse{
+		ClusterNames: make([]string, 0, len(clusters)),
+		ClusterInfos: make(map[string]*rpcpb.ClusterInfo, len(clusters)),
+	}
+	for _, c := range clusters {
+		info := c.getInfo()
+		if info == nil {
+			// still starting
+			continue
+		}
+		resp.ClusterNames = append(resp.ClusterNames, c.name)
+		resp.ClusterInfos[c.name] = info
+	}
+	sort.Strings(resp.ClusterNames)
+	return resp, nil
 }
 
 func (s *server) StreamStatus(req *rpcpb.StreamStatusRequest, stream rpcpb.ControlService_StreamStatusServer) (err error) {
-	zap.L().Info("received bootstrap status request")
-	if s.getClusterInfo() == nil {
-		return ErrNotBootstrapped
+	zap.L().Info("received bootstrap status request", zap.String("cluster", req.GetClusterName()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return err
 	}
 
 	interval := time.Duration(req.PushInterval)
@@ -296,7 +532,7 @@ func (s *server) StreamStatus(req *rpcpb.StreamStatusRequest, stream rpcpb.Contr
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
-		s.sendLoop(stream, interval)
+		s.sendLoop(stream, c, interval)
 		wg.Done()
 	}()
 
@@ -329,7 +565,7 @@ func (s *server) StreamStatus(req *rpcpb.StreamStatusRequest, stream rpcpb.Contr
 	return err
 }
 
-func (s *server) sendLoop(stream rpcpb.ControlService_StreamStatusServer, interval time.Duration) {
+func (s *server) sendLoop(stream rpcpb.ControlService_StreamStatusServer, c *cluster, interval time.Duration) {
 	zap.L().Info("start status send loop")
 
 	tc := time.NewTicker(1)
@@ -346,7 +582,7 @@ func (s *server) sendLoop(stream rpcpb.ControlService_StreamStatusServer, interv
 		}
 
 		zap.L().Debug("sending cluster info")
-		if err := stream.Send(&rpcpb.StreamStatusResponse{ClusterInfo: s.getClusterInfo()}); err != nil {
+		if err := stream.Send(&rpcpb.StreamStatusResponse{ClusterInfo: c.getInfo()}); err != nil {
 			if isClientCanceled(stream.Context().Err(), err) {
 				zap.L().Debug("client stream canceled", zap.Error(err))
 				return
@@ -382,143 +618,502 @@ func (s *server) recvLoop(stream rpcpb.ControlService_StreamStatusServer) error
 	}
 }
 
-func (s *server) RemoveNode(ctx context.Context, req *rpcpb.RemoveNodeRequest) (*rpcpb.RemoveNodeResponse, error) {
-	zap.L().Debug("received remove node request", zap.String("name", req.Name))
-	if info := s.getClusterInfo(); info == nil {
-		return nil, ErrNotBootstrapped
+func (s *server) WatchEvents(req *rpcpb.WatchEventsRequest, stream rpcpb.ControlService_WatchEventsServer) error {
+	zap.L().Info("received watch events request", zap.String("cluster", req.GetClusterName()), zap.Uint64("start-revision", req.GetStartRevision()))
+	// last sent revision
+	rev := s.events.current()
+	if req.GetStartRevision() > 0 {
+		rev = req.GetStartRevision() - 1
 	}
+	for {
+		evs, updatec, err := s.events.since(rev)
+		if err != nil {
+			return err
+		}
+		for _, ev := range evs {
+			rev = ev.Revision
+			if req.GetClusterName() != "" && ev.ClusterName != req.GetClusterName() {
+				continue
+			}
+			if err := stream.Send(&rpcpb.WatchEventsResponse{Event: ev}); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-updatec:
+		case <-s.closed:
+			return ErrClosed
+		case <-stream.Context().Done():
+			// client is done watching
+			return nil
+		}
+	}
+}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *server) StreamLogs(req *rpcpb.StreamLogsRequest, stream rpcpb.ControlService_StreamLogsServer) error {
+	zap.L().Info("received stream logs request", zap.Strings("nodes", req.GetNodeNames()), zap.String("stream", req.GetStream()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return err
+	}
+	nw, err := c.getNetwork()
+	if err != nil {
+		return err
+	}
+	info := c.getInfo()
+	nodeNames := req.GetNodeNames()
+	if len(nodeNames) == 0 {
+		nodeNames = info.NodeNames
+	}
+	streamName := req.GetStream()
+	if streamName == "" {
+		streamName = logStreamStdout
+	}
 
-	if _, ok := s.network.nodeInfos[req.Name]; !ok {
-		return nil, ErrNodeNotFound
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// gRPC stream does not support concurrent sends
+	var sendMu sync.Mutex
+	readers := make([]func() error, 0, len(nodeNames))
+	for _, name := range nodeNames {
+		name := name
+		send := func(line string) error {
+			sendMu.Lock()
+			defer sendMu.Unlock()
+			return stream.Send(&rpcpb.StreamLogsResponse{NodeName: name, Stream: streamName, Line: line})
+		}
+		switch streamName {
+		case logStreamStdout, logStreamStderr:
+			buf, err := nw.logs.get(name, streamName)
+			if err != nil {
+				return err
+			}
+			readers = append(readers, func() error {
+				return streamLogBuffer(ctx, buf, req.GetTail(), req.GetFollow(), send)
+			})
+		default:
+			nodeInfo, ok := info.NodeInfos[name]
+			if !ok {
+				return fmt.Errorf("%w: %q", ErrNodeNotFound, name)
+			}
+			path, err := logFilePath(nodeInfo.LogDir, streamName)
+			if err != nil {
+				return err
+			}
+			readers = append(readers, func() error {
+				return streamLogFile(ctx, path, req.GetTail(), req.GetFollow(), send)
+			})
+		}
 	}
 
-	if err := s.network.nw.RemoveNode(req.Name); err != nil {
-		return nil, err
+	errc := make(chan error, len(readers))
+	for _, read := range readers {
+		go func(read func() error) {
+			errc <- read()
+		}(read)
+	}
+	for range readers {
+		if rerr := <-errc; rerr != nil && err == nil {
+			err = rerr
+			// stop the other readers
+			cancel()
+		}
 	}
-	delete(s.network.nodeInfos, req.Name)
-	s.network.nodeNames = make([]string, 0)
-	for name := range s.network.nodeInfos {
-		s.network.nodeNames = append(s.network.nodeNames, name)
+	if errors.Is(err, context.Canceled) && stream.Context().Err() != nil {
+		// client is done following
+		return nil
 	}
-	s.clusterInfo.NodeNames = s.network.nodeNames
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	return err
+}
 
-	zap.L().Info("waiting for healthy")
-	if err := s.network.waitForHealthy(); err != nil {
+func (s *server) AddNode(ctx context.Context, req *rpcpb.AddNodeRequest) (*rpcpb.AddNodeResponse, error) {
+	zap.L().Debug("received add node request", zap.String("name", req.GetNodeSpec().GetName()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := c.getInfo().NodeInfos[req.GetNodeSpec().GetName()]; ok {
+		return nil, ErrDuplicateNode
+	}
+	if err := checkChainConfigs(req.GetNodeSpec().GetChainConfigs()); err != nil {
 		return nil, err
 	}
 
-	return &rpcpb.RemoveNodeResponse{ClusterInfo: s.clusterInfo}, nil
+	op := s.ops.run(s.rootCtx, "AddNode", c, func(ctx context.Context, _ *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
+
+		err = nw.addNode(ctx, req.GetNodeSpec(), req.GetValidator())
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.AddNodeResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
 }
 
-func (s *server) RestartNode(ctx context.Context, req *rpcpb.RestartNodeRequest) (*rpcpb.RestartNodeResponse, error) {
+func (s *server) RemoveNode(ctx context.Context, req *rpcpb.RemoveNodeRequest) (*rpcpb.RemoveNodeResponse, error) {
 	zap.L().Debug("received remove node request", zap.String("name", req.Name))
-	if info := s.getClusterInfo(); info == nil {
-		return nil, ErrNotBootstrapped
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
 	}
-
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	nodeInfo, ok := s.network.nodeInfos[req.Name]
-	if !ok {
+	if _, ok := c.getInfo().NodeInfos[req.Name]; !ok {
 		return nil, ErrNodeNotFound
 	}
 
-	found, idx := false, 0
-	oldNodeConfig := node.Config{}
-	for i, cfg := range s.network.cfg.NodeConfigs {
-		if cfg.Name == req.Name {
-			oldNodeConfig = cfg
-			found = true
-			idx = i
-			break
+	op := s.ops.run(s.rootCtx, "RemoveNode", c, func(ctx context.Context, _ *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
 		}
+		defer c.unlockNetwork()
+
+		start := time.Now()
+		err = nw.removeNode(ctx, req.Name)
+		s.metrics.observeHealthy("RemoveNode", start, err)
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.RemoveNodeResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
+
+func (s *server) RestartNode(ctx context.Context, req *rpcpb.RestartNodeRequest) (*rpcpb.RestartNodeResponse, error) {
+	zap.L().Debug("received restart node request", zap.String("name", req.Name))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
 	}
-	if !found {
+	if _, ok := c.getInfo().NodeInfos[req.Name]; !ok {
 		return nil, ErrNodeNotFound
 	}
-	nodeConfig := oldNodeConfig
-
-	// keep everything same except config file and binary path
-	nodeInfo.ExecPath = req.StartRequest.ExecPath
-	nodeInfo.WhitelistedSubnets = *req.StartRequest.WhitelistedSubnets
-	nodeConfig.ConfigFile = []byte(fmt.Sprintf(`{
-	"network-peer-list-gossip-frequency":"250ms",
-	"network-max-reconnect-delay":"1s",
-	"public-ip":"127.0.0.1",
-	"health-check-frequency":"2s",
-	"api-admin-enabled":true,
-	"api-ipcs-enabled":true,
-	"index-enabled":true,
-	"log-display-level":"INFO",
-	"log-level":"INFO",
-	"log-dir":"%s",
-	"db-dir":"%s",
-	"whitelisted-subnets":"%s"
-}`,
-		nodeInfo.LogDir,
-		nodeInfo.DbDir,
-		nodeInfo.WhitelistedSubnets,
-	))
-	implCfg := nodeConfig.ImplSpecificConfig
-	lcfg, ok := implCfg.(local.NodeConfig)
-	if !ok {
-		return nil, ErrUnexpectedType
+	if err := checkChainConfigs(req.GetChainConfigs()); err != nil {
+		return nil, err
 	}
-	lcfg.BinaryPath = nodeInfo.ExecPath
-	nodeConfig.ImplSpecificConfig = lcfg
 
-	// now remove the node before restart
-	zap.L().Info("removing the node")
-	if err := s.network.nw.RemoveNode(req.Name); err != nil {
+	op := s.ops.run(s.rootCtx, "RestartNode", c, func(ctx context.Context, _ *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
+
+		if err := nw.setChainConfigs(req.Name, req.GetChainConfigs()); err != nil {
+			return err
+		}
+		start := time.Now()
+		err = nw.restartNode(
+			ctx,
+			req.Name,
+			req.GetStartRequest().GetExecPath(),
+			req.GetStartRequest().GetWhitelistedSubnets(),
+			req.GetConfig(),
+		)
+		s.metrics.observeHealthy("RestartNode", start, err)
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.RestartNodeResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
+
+func (s *server) RollingUpgrade(ctx context.Context, req *rpcpb.RollingUpgradeRequest) (*rpcpb.RollingUpgradeResponse, error) {
+	zap.L().Debug("received rolling upgrade request", zap.String("exec-path", req.GetExecPath()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	opts, err := newRollingUpgradeOptions(req, c.getInfo())
+	if err != nil {
 		return nil, err
 	}
 
-	// now adding the new node
-	zap.L().Info("adding the node")
-	if _, err := s.network.nw.AddNode(nodeConfig); err != nil {
+	op := s.ops.run(s.rootCtx, "RollingUpgrade", c, func(ctx context.Context, o *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
+
+		err = nw.rollingUpgrade(ctx, opts, func(report *rpcpb.RollingUpgradeReport) {
+			c.publish(nw)
+			o.update(func(op *rpcpb.Operation) {
+				op.RollingUpgradeReport = report
+			})
+		})
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.RollingUpgradeResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
+
+func (s *server) PauseNode(ctx context.Context, req *rpcpb.PauseNodeRequest) (*rpcpb.PauseNodeResponse, error) {
+	zap.L().Debug("received pause node request", zap.String("name", req.Name))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
 		return nil, err
 	}
+	if _, ok := c.getInfo().NodeInfos[req.Name]; !ok {
+		return nil, ErrNodeNotFound
+	}
 
-	zap.L().Info("waiting for healthy")
-	if err := s.network.waitForHealthy(); err != nil {
+	op := s.ops.run(s.rootCtx, "PauseNode", c, func(ctx context.Context, _ *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
+
+		err = nw.pauseNode(req.Name)
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.PauseNodeResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
+
+func (s *server) ResumeNode(ctx context.Context, req *rpcpb.ResumeNodeRequest) (*rpcpb.ResumeNodeResponse, error) {
+	zap.L().Debug("received resume node request", zap.String("name", req.Name))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
 		return nil, err
 	}
+	if _, ok := c.getInfo().NodeInfos[req.Name]; !ok {
+		return nil, ErrNodeNotFound
+	}
 
-	// update with the new config
-	s.network.cfg.NodeConfigs[idx] = nodeConfig
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	op := s.ops.run(s.rootCtx, "ResumeNode", c, func(ctx context.Context, _ *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
 
-	return &rpcpb.RestartNodeResponse{ClusterInfo: s.clusterInfo}, nil
+		err = nw.resumeNode(req.Name)
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.ResumeNodeResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
 }
 
 func (s *server) Stop(ctx context.Context, req *rpcpb.StopRequest) (*rpcpb.StopResponse, error) {
-	zap.L().Debug("received stop request")
-	info := s.getClusterInfo()
-	if info == nil {
+	zap.L().Debug("received stop request", zap.String("cluster", req.GetClusterName()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	c.mu.RLock()
+	nw := c.network
+	c.mu.RUnlock()
+	if nw == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	// make the ongoing start and operations return
+	nw.abort()
+	if _, err := c.lockNetwork(ctx); err != nil {
+		return nil, err
+	}
+	defer c.unlockNetwork()
+	nw.stop()
 
-	s.network.stop()
-	s.network = nil
+	info, err := s.removeCluster(c, nw)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.StopResponse{ClusterInfo: info}, nil
+}
+
+// removeCluster removes the cluster of the stopped network [nw],
+// and returns its last info.
+func (s *server) removeCluster(c *cluster, nw *localNetwork) (*rpcpb.ClusterInfo, error) {
+	c.mu.Lock()
+	if c.network != nw {
+		c.mu.Unlock()
+		return nil, ErrNotBootstrapped
+	}
+	info := proto.Clone(c.info).(*rpcpb.ClusterInfo)
 	info.Healthy = false
-	s.clusterInfo = nil
+	c.network = nil
+	c.info = nil
+	c.mu.Unlock()
+	s.deleteCluster(c.name)
+	s.events.publish(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_CLUSTER_STOPPED, ClusterName: c.name})
+	return info, nil
+}
 
-	return &rpcpb.StopResponse{ClusterInfo: info}, nil
+func (s *server) SaveSnapshot(ctx context.Context, req *rpcpb.SaveSnapshotRequest) (*rpcpb.SaveSnapshotResponse, error) {
+	zap.L().Info("received save snapshot request",
+		zap.String("cluster", req.GetClusterName()),
+		zap.String("snapshot", req.GetSnapshotName()),
+	)
+	if err := checkSnapshotName(req.GetSnapshotName()); err != nil {
+		return nil, err
+	}
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(s.cfg.SnapshotsDir, req.GetSnapshotName())
+	if _, err := os.Stat(dir); err == nil {
+		return nil, ErrSnapshotExists
+	}
+
+	op := s.ops.run(s.rootCtx, "SaveSnapshot", c, func(ctx context.Context, _ *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
+
+		if !nw.healthy {
+			return ErrNotHealthy
+		}
+		if _, err := os.Stat(dir); err == nil {
+			return ErrSnapshotExists
+		}
+
+		// nodes must be stopped for consistent databases
+		nw.stop()
+		if err := nw.saveSnapshot(dir); err != nil {
+			os.RemoveAll(dir)
+			// keep the stopped cluster and its data, until "Stop"
+			nw.healthy = false
+			c.publish(nw)
+			return fmt.Errorf("cluster stopped, data kept in %q: %w", nw.rootDataDir, err)
+		}
+		zap.L().Info("saved snapshot", zap.String("path", dir))
+
+		if _, err := s.removeCluster(c, nw); err != nil {
+			return err
+		}
+		// the snapshot has a copy of the node databases
+		if err := os.RemoveAll(nw.rootDataDir); err != nil {
+			zap.L().Warn("failed to remove root data dir", zap.Error(err))
+		}
+		return nil
+	})
+	return &rpcpb.SaveSnapshotResponse{SnapshotPath: dir, Operation: op}, nil
 }
 
-func (s *server) getClusterInfo() *rpcpb.ClusterInfo {
-	s.mu.RLock()
-	info := s.clusterInfo
-	s.mu.RUnlock()
-	return info
+func (s *server) LoadSnapshot(ctx context.Context, req *rpcpb.LoadSnapshotRequest) (*rpcpb.LoadSnapshotResponse, error) {
+	c, err := s.loadSnapshot(req)
+	if err != nil {
+		return nil, err
+	}
+	op := s.ops.run(s.rootCtx, "LoadSnapshot", c, func(ctx context.Context, _ *operation) error {
+		return s.waitForCluster(ctx, c)
+	})
+	return &rpcpb.LoadSnapshotResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
+
+func (s *server) loadSnapshot(req *rpcpb.LoadSnapshotRequest) (*cluster, error) {
+	name, err := checkClusterName(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	zap.L().Info("received load snapshot request",
+		zap.String("cluster", name),
+		zap.String("snapshot", req.GetSnapshotName()),
+	)
+	if err := checkSnapshotName(req.GetSnapshotName()); err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(s.cfg.SnapshotsDir, req.GetSnapshotName())
+	if _, err := os.Stat(filepath.Join(dir, snapshotFile)); err != nil {
+		return nil, ErrSnapshotNotFound
+	}
+
+	c, err := s.reserveCluster(name)
+	if err != nil {
+		return nil, err
+	}
+	defer c.mu.Unlock()
+
+	info, err := newClusterInfo(name)
+	if err != nil {
+		s.deleteCluster(name)
+		return nil, err
+	}
+	c.network, err = newNetworkFromSnapshot(dir, localNetworkOptions{
+		execPath:    req.GetExecPath(),
+		rootDataDir: info.RootDataDir,
+		logLevel:    req.GetLogLevel(),
+		ports:       s.ports,
+		clusterName: name,
+		events:      s.events,
+	})
+	if err != nil {
+		s.deleteCluster(name)
+		os.RemoveAll(info.RootDataDir)
+		return nil, err
+	}
+	s.runCluster(c, info)
+	return c, nil
+}
+
+func (s *server) ListSnapshots(ctx context.Context, req *rpcpb.ListSnapshotsRequest) (*rpcpb.ListSnapshotsResponse, error) {
+	zap.L().Debug("received list snapshots request")
+	names, err := listSnapshots(s.cfg.SnapshotsDir)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.ListSnapshotsResponse{SnapshotNames: names}, nil
+}
+
+func (s *server) RemoveSnapshot(ctx context.Context, req *rpcpb.RemoveSnapshotRequest) (*rpcpb.RemoveSnapshotResponse, error) {
+	zap.L().Info("received remove snapshot request", zap.String("snapshot", req.GetSnapshotName()))
+	if err := checkSnapshotName(req.GetSnapshotName()); err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(s.cfg.SnapshotsDir, req.GetSnapshotName())
+	if _, err := os.Stat(dir); err != nil {
+		return nil, ErrSnapshotNotFound
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	return &rpcpb.RemoveSnapshotResponse{}, nil
+}
+
+func (s *server) GetOperation(ctx context.Context, req *rpcpb.GetOperationRequest) (*rpcpb.GetOperationResponse, error) {
+	zap.L().Debug("received get operation request", zap.String("id", req.GetId()))
+	op, err := s.ops.get(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetOperationResponse{Operation: op}, nil
+}
+
+func (s *server) ListOperations(ctx context.Context, req *rpcpb.ListOperationsRequest) (*rpcpb.ListOperationsResponse, error) {
+	zap.L().Debug("received list operations request", zap.String("cluster", req.GetClusterName()))
+	return &rpcpb.ListOperationsResponse{Operations: s.ops.list(req.GetClusterName())}, nil
+}
+
+func (s *server) WaitOperation(ctx context.Context, req *rpcpb.WaitOperationRequest) (*rpcpb.WaitOperationResponse, error) {
+	zap.L().Debug("received wait operation request", zap.String("id", req.GetId()))
+	op, err := s.ops.wait(ctx, req.GetId())
+	if op == nil {
+		return nil, err
+	}
+	// a failed operation is reported in the response
+	return &rpcpb.WaitOperationResponse{Operation: op}, nil
+}
+
+func (s *server) CancelOperation(ctx context.Context, req *rpcpb.CancelOperationRequest) (*rpcpb.CancelOperationResponse, error) {
+	zap.L().Info("received cancel operation request", zap.String("id", req.GetId()))
+	op, err := s.ops.cancel(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.CancelOperationResponse{Operation: op}, nil
 }
 
 func isClientCanceled(ctxErr error, err error) bool {
@@ -0,0 +1,265 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeNodeEnv makes the test binary run as a fake avalanchego (see
+// "runFakeNode"), for the nodes launched by the tests.
+const fakeNodeEnv = "AVAX_TESTER_FAKE_NODE"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(fakeNodeEnv) != "" {
+		if err := runFakeNode(os.Args[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// newFakeNode returns the path of the test binary, which runs as a node
+// that serves a healthy health API and its node ID on its HTTP port.
+func newFakeNode(t *testing.T) string {
+	t.Helper()
+	t.Setenv(fakeNodeEnv, "1")
+	return os.Args[0]
+}
+
+// runFakeNode creates the node database directory, and serves the node
+// APIs on the HTTP port until killed. The flags take precedence over the
+// "--config-file".
+func runFakeNode(args []string) error {
+	flags := make(map[string]string)
+	for _, arg := range args {
+		kv := strings.SplitN(strings.TrimPrefix(arg, "--"), "=", 2)
+		if len(kv) == 2 {
+			flags[kv[0]] = kv[1]
+		}
+	}
+	if path := flags["config-file"]; path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		m := make(map[string]interface{})
+		if err := json.Unmarshal(b, &m); err != nil {
+			return err
+		}
+		for k, v := range m {
+			if _, ok := flags[k]; !ok {
+				flags[k] = fmt.Sprint(v)
+			}
+		}
+	}
+	if err := os.MkdirAll(flags["db-dir"], 0o750); err != nil {
+		return err
+	}
+	key, err := ioutil.ReadFile(flags["staking-tls-key-file"])
+	if err != nil {
+		return err
+	}
+	cert, err := ioutil.ReadFile(flags["staking-tls-cert-file"])
+	if err != nil {
+		return err
+	}
+	nodeID, err := utils.ToNodeID(key, cert)
+	if err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", flags["http-port"]))
+	if err != nil {
+		return err
+	}
+	return http.Serve(ln, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     interface{} `json:"id"`
+			Method string      `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "health.health":
+			resp["result"] = map[string]interface{}{"checks": map[string]interface{}{}, "healthy": true}
+		case "info.getNodeID":
+			resp["result"] = map[string]interface{}{"nodeID": nodeID.PrefixedString(constants.NodeIDPrefix)}
+		default:
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+// getInfo returns the info of the default cluster.
+func getInfo(t *testing.T, s *server) *rpcpb.ClusterInfo {
+	t.Helper()
+	c, err := s.getCluster("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.getInfo()
+}
+
+// waitOperation waits for the operation [op] to succeed.
+func waitOperation(t *testing.T, s *server, op *rpcpb.Operation) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	done, err := s.ops.wait(ctx, op.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if done.State != rpcpb.OperationState_OPERATION_STATE_SUCCEEDED {
+		t.Fatalf("operation %s %s: %s", done.Type, done.State, done.Error)
+	}
+}
+
+// newTestServer returns a server that is not serving, for calling the
+// handlers directly.
+func newTestServer(t *testing.T) *server {
+	t.Helper()
+	srv, err := New(Config{
+		Port:         "127.0.0.1:0",
+		GwPort:       "127.0.0.1:0",
+		SnapshotsDir: t.TempDir(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := srv.(*server)
+	s.rootCtx = context.Background()
+	t.Cleanup(func() {
+		s.ln.Close()
+	})
+	return s
+}
+
+func TestLoadSnapshotReturns(t *testing.T) {
+	s := newTestServer(t)
+	execPath := newFakeBinary(t)
+	if err := newTestNetwork(t, execPath, 1, nil).saveSnapshot(filepath.Join(s.cfg.SnapshotsDir, "snap")); err != nil {
+		t.Fatal(err)
+	}
+
+	respc := make(chan *rpcpb.LoadSnapshotResponse, 1)
+	errc := make(chan error, 1)
+	go func() {
+		resp, err := s.LoadSnapshot(context.Background(), &rpcpb.LoadSnapshotRequest{SnapshotName: "snap"})
+		respc <- resp
+		errc <- err
+	}()
+	var resp *rpcpb.LoadSnapshotResponse
+	select {
+	case resp = <-respc:
+	case <-time.After(10 * time.Second):
+		t.Fatal("LoadSnapshot did not return")
+	}
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+	if resp.Operation.State != rpcpb.OperationState_OPERATION_STATE_RUNNING {
+		t.Fatalf("unexpected operation state %q", resp.Operation.State)
+	}
+
+	// the cluster is not wedged
+	getc := make(chan error, 1)
+	go func() {
+		_, err := s.getCluster("")
+		getc <- err
+	}()
+	select {
+	case err := <-getc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("cluster is locked")
+	}
+
+	// the nodes never become healthy, so cancel to roll back
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	op, err := s.ops.cancel(ctx, resp.Operation.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.State != rpcpb.OperationState_OPERATION_STATE_CANCELED {
+		t.Fatalf("unexpected operation state %q", op.State)
+	}
+	if _, err := s.getCluster(""); err == nil {
+		t.Fatal("canceled cluster not rolled back")
+	}
+}
+
+func TestNodeOperations(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.Start(context.Background(), &rpcpb.StartRequest{ExecPath: newFakeNode(t), NumberOfNodes: proto.Uint32(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		// no-op once saved
+		s.Stop(context.Background(), &rpcpb.StopRequest{})
+	})
+	waitOperation(t, s, resp.Operation)
+
+	pauseResp, err := s.PauseNode(context.Background(), &rpcpb.PauseNodeRequest{Name: "node1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOperation(t, s, pauseResp.Operation)
+	if !getInfo(t, s).NodeInfos["node1"].Paused {
+		t.Fatal("node not paused")
+	}
+	resumeResp, err := s.ResumeNode(context.Background(), &rpcpb.ResumeNodeRequest{Name: "node1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOperation(t, s, resumeResp.Operation)
+	if getInfo(t, s).NodeInfos["node1"].Paused {
+		t.Fatal("node not resumed")
+	}
+	if _, err := s.PauseNode(context.Background(), &rpcpb.PauseNodeRequest{Name: "node2"}); err != ErrNodeNotFound {
+		t.Fatalf("expected %v, got %v", ErrNodeNotFound, err)
+	}
+
+	// the cluster is stopped once saved, and its data moved into the snapshot
+	rootDataDir := getInfo(t, s).RootDataDir
+	saveResp, err := s.SaveSnapshot(context.Background(), &rpcpb.SaveSnapshotRequest{SnapshotName: "snap"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitOperation(t, s, saveResp.Operation)
+	if _, err := os.Stat(filepath.Join(saveResp.SnapshotPath, snapshotFile)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.getCluster(""); err != ErrNotBootstrapped {
+		t.Fatalf("expected %v, got %v", ErrNotBootstrapped, err)
+	}
+	if _, err := os.Stat(rootDataDir); !os.IsNotExist(err) {
+		t.Fatalf("root data dir %q not removed: %v", rootDataDir, err)
+	}
+}
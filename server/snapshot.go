@@ -0,0 +1,402 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// snapshotFile describes the cluster, and is stored in the snapshot
+// directory along with a copy of each node's "db-dir".
+const snapshotFile = "snapshot.json"
+
+var (
+	ErrInvalidSnapshotName = errors.New("invalid snapshot name")
+	ErrSnapshotExists      = errors.New("snapshot already exists")
+	ErrSnapshotNotFound    = errors.New("snapshot not found")
+	ErrInvalidSnapshot     = errors.New("invalid snapshot")
+)
+
+type snapshot struct {
+	ExecPath           string         `json:"execPath"`
+	LogLevel           string         `json:"logLevel"`
+	WhitelistedSubnets string         `json:"whitelistedSubnets"`
+	GlobalNodeConfig   string         `json:"globalNodeConfig"`
+	RootDataDir        string         `json:"rootDataDir"`
+	Genesis            string         `json:"genesis"`
+	Nodes              []snapshotNode `json:"nodes"`
+	SubnetIDs          []string       `json:"subnetIDs,omitempty"`
+	// "rpcpb.BlockchainInfo" in the protobuf JSON format
+	Blockchains []json.RawMessage `json:"blockchains,omitempty"`
+	// defaults of the added nodes
+	ChainConfigs map[string]snapshotChainConfig `json:"chainConfigs,omitempty"`
+	// protojson encoded
+	RestartPolicy json.RawMessage `json:"restartPolicy,omitempty"`
+	PortRange     json.RawMessage `json:"portRange,omitempty"`
+}
+
+type snapshotChainConfig struct {
+	Config  string `json:"config,omitempty"`
+	Upgrade string `json:"upgrade,omitempty"`
+}
+
+type snapshotNode struct {
+	Name             string `json:"name"`
+	ExecPath         string `json:"execPath"`
+	IsBeacon         bool   `json:"isBeacon"`
+	StakingKey       string `json:"stakingKey"`
+	StakingCert      string `json:"stakingCert"`
+	ConfigFile       string `json:"configFile"`
+	CChainConfigFile string `json:"cChainConfigFile"`
+	// protojson encoded
+	RestartPolicy json.RawMessage                `json:"restartPolicy,omitempty"`
+	ChainConfigs  map[string]snapshotChainConfig `json:"chainConfigs,omitempty"`
+}
+
+func checkSnapshotName(name string) error {
+	if !nameRegex.MatchString(name) {
+		return ErrInvalidSnapshotName
+	}
+	return nil
+}
+
+// relDir returns the path of [path] relative to [dir], and false if
+// [path] is not under [dir].
+func relDir(dir string, path string) (string, bool) {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
+
+// saveSnapshot writes the cluster configuration and a copy of each
+// node's database to [dir]. The network must be stopped. A database
+// outside the root data directory is moved under it on load.
+func (lc *localNetwork) saveSnapshot(dir string) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	snap := snapshot{
+		ExecPath:           lc.binPath,
+		LogLevel:           lc.logLevel,
+		WhitelistedSubnets: lc.whitelistedSubnets,
+		GlobalNodeConfig:   lc.globalNodeConfig,
+		RootDataDir:        lc.rootDataDir,
+		Genesis:            string(lc.cfg.Genesis),
+		SubnetIDs:          lc.subnetIDs,
+		ChainConfigs:       toSnapshotChainConfigs(lc.chainConfigs),
+	}
+	if lc.restartPolicy != nil {
+		b, err := protojson.Marshal(lc.restartPolicy)
+		if err != nil {
+			return err
+		}
+		snap.RestartPolicy = b
+	}
+	if lc.portRange != nil {
+		b, err := protojson.Marshal(lc.portRange)
+		if err != nil {
+			return err
+		}
+		snap.PortRange = b
+	}
+	for _, cfg := range lc.cfg.NodeConfigs {
+		// removed nodes are still in the network config
+		info, ok := lc.nodeInfos[cfg.Name]
+		if !ok {
+			continue
+		}
+		configFile := cfg.ConfigFile
+		if _, ok := relDir(lc.rootDataDir, info.DbDir); !ok {
+			m := make(map[string]interface{})
+			if err := json.Unmarshal(configFile, &m); err != nil {
+				return err
+			}
+			m["db-dir"] = filepath.Join(lc.rootDataDir, cfg.Name, "db-dir")
+			b, err := json.Marshal(m)
+			if err != nil {
+				return err
+			}
+			configFile = b
+		}
+		sn := snapshotNode{
+			Name:             cfg.Name,
+			ExecPath:         info.ExecPath,
+			IsBeacon:         cfg.IsBeacon,
+			StakingKey:       string(cfg.StakingKey),
+			StakingCert:      string(cfg.StakingCert),
+			ConfigFile:       string(configFile),
+			CChainConfigFile: string(cfg.CChainConfigFile),
+			ChainConfigs:     toSnapshotChainConfigs(info.ChainConfigs),
+		}
+		if info.RestartPolicy != nil {
+			b, err := protojson.Marshal(info.RestartPolicy)
+			if err != nil {
+				return err
+			}
+			sn.RestartPolicy = b
+		}
+		snap.Nodes = append(snap.Nodes, sn)
+		if err := copyDir(info.DbDir, filepath.Join(dir, cfg.Name, "db-dir")); err != nil {
+			return fmt.Errorf("node %q: %w", cfg.Name, err)
+		}
+		// VM plugins, without the links to the binary plugins
+		if buildDir := lc.nodeBuildDir(cfg.Name); dirExists(buildDir) {
+			if err := copyDir(buildDir, filepath.Join(dir, cfg.Name, "build")); err != nil {
+				return fmt.Errorf("node %q: %w", cfg.Name, err)
+			}
+		}
+	}
+	for _, bc := range lc.blockchains {
+		b, err := protojson.Marshal(bc)
+		if err != nil {
+			return err
+		}
+		snap.Blockchains = append(snap.Blockchains, b)
+	}
+
+	b, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, snapshotFile), b, 0o600)
+}
+
+// newNetworkFromSnapshot returns a network that runs the cluster saved
+// in [dir], with the node databases copied to [opts.rootDataDir].
+// A non-empty [opts.execPath] overrides the binaries of all nodes.
+func newNetworkFromSnapshot(dir string, opts localNetworkOptions) (*localNetwork, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, snapshotFile))
+	if err != nil {
+		return nil, err
+	}
+	var snap snapshot
+	if err := json.Unmarshal(b, &snap); err != nil {
+		return nil, err
+	}
+	if opts.execPath == "" {
+		opts.execPath = snap.ExecPath
+	}
+	if opts.logLevel == "" {
+		opts.logLevel = snap.LogLevel
+	}
+	opts.whitelistedSubnets = snap.WhitelistedSubnets
+	opts.globalNodeConfig = snap.GlobalNodeConfig
+	opts.chainConfigs = fromSnapshotChainConfigs(snap.ChainConfigs)
+	if len(snap.RestartPolicy) > 0 {
+		opts.restartPolicy = &rpcpb.RestartPolicy{}
+		if err := protojson.Unmarshal(snap.RestartPolicy, opts.restartPolicy); err != nil {
+			return nil, err
+		}
+	}
+	if len(snap.PortRange) > 0 {
+		opts.portRange = &rpcpb.PortRange{}
+		if err := protojson.Unmarshal(snap.PortRange, opts.portRange); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg := local.NewDefaultConfig(opts.execPath)
+	cfg.Genesis = []byte(snap.Genesis)
+	cfg.NodeConfigs = make([]node.Config, len(snap.Nodes))
+	nodeNames := make([]string, len(snap.Nodes))
+	nodeInfos := make(map[string]*rpcpb.NodeInfo, len(snap.Nodes))
+	logs := newNodeLogs()
+	for i, sn := range snap.Nodes {
+		execPath := sn.ExecPath
+		if opts.execPath != snap.ExecPath {
+			execPath = opts.execPath
+		}
+		if _, err := os.Stat(execPath); err != nil {
+			return nil, ErrNotExists
+		}
+
+		// point the directories at the new root data directory
+		m := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(sn.ConfigFile), &m); err != nil {
+			return nil, err
+		}
+		for _, k := range []string{"log-dir", "db-dir", buildDirKey, chainConfigDirKey} {
+			if v, ok := m[k].(string); ok {
+				if rel, ok := relDir(snap.RootDataDir, v); ok {
+					m[k] = filepath.Join(opts.rootDataDir, rel)
+				}
+			}
+		}
+		// the database is copied, and must not overwrite another directory
+		dbDir, _ := m["db-dir"].(string)
+		if _, ok := relDir(opts.rootDataDir, dbDir); !ok {
+			return nil, fmt.Errorf("%w: node %q db-dir %q not under %q", ErrInvalidSnapshot, sn.Name, dbDir, snap.RootDataDir)
+		}
+		if opts.logLevel != snap.LogLevel {
+			m["log-level"] = strings.ToUpper(opts.logLevel)
+		}
+		configFile, err := json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		info := &rpcpb.NodeInfo{
+			Name:         sn.Name,
+			ExecPath:     execPath,
+			ChainConfigs: fromSnapshotChainConfigs(sn.ChainConfigs),
+		}
+		if len(sn.RestartPolicy) > 0 {
+			info.RestartPolicy = &rpcpb.RestartPolicy{}
+			if err := protojson.Unmarshal(sn.RestartPolicy, info.RestartPolicy); err != nil {
+				return nil, fmt.Errorf("node %q: %w", sn.Name, err)
+			}
+		}
+		if err := updateNodeInfo(info, configFile); err != nil {
+			return nil, err
+		}
+		if err := copyDir(filepath.Join(dir, sn.Name, "db-dir"), info.DbDir); err != nil {
+			return nil, fmt.Errorf("node %q: %w", sn.Name, err)
+		}
+		if buildDir := filepath.Join(dir, sn.Name, "build"); dirExists(buildDir) {
+			if err := copyDir(buildDir, filepath.Join(opts.rootDataDir, sn.Name, "build")); err != nil {
+				return nil, fmt.Errorf("node %q: %w", sn.Name, err)
+			}
+		}
+
+		stdout, stderr := logs.writers(sn.Name, colors[i%len(colors)])
+		cfg.NodeConfigs[i] = node.Config{
+			Name:        sn.Name,
+			IsBeacon:    sn.IsBeacon,
+			StakingKey:  []byte(sn.StakingKey),
+			StakingCert: []byte(sn.StakingCert),
+			ConfigFile:  configFile,
+			ImplSpecificConfig: local.NodeConfig{
+				BinaryPath: execPath,
+				Stdout:     stdout,
+				Stderr:     stderr,
+			},
+		}
+		if sn.CChainConfigFile != "" {
+			cfg.NodeConfigs[i].CChainConfigFile = []byte(sn.CChainConfigFile)
+		}
+		info.Id, err = setStakingKey(&cfg.NodeConfigs[i], nil)
+		if err != nil {
+			return nil, fmt.Errorf("node %q: %w", sn.Name, err)
+		}
+		nodeNames[i] = sn.Name
+		nodeInfos[sn.Name] = info
+	}
+
+	lc, err := newLocalNetwork(opts, cfg, nodeNames, nodeInfos, logs)
+	if err != nil {
+		return nil, err
+	}
+	lc.subnetIDs = snap.SubnetIDs
+	for _, b := range snap.Blockchains {
+		bc := &rpcpb.BlockchainInfo{}
+		if err := protojson.Unmarshal(b, bc); err != nil {
+			return nil, err
+		}
+		lc.blockchains = append(lc.blockchains, bc)
+	}
+	return lc, nil
+}
+
+// listSnapshots returns the sorted names of the snapshots in [dir].
+func listSnapshots(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, e.Name(), snapshotFile)); err != nil {
+			// incomplete
+			continue
+		}
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// copyDir recursively copies the directory [src] to [dst].
+func copyDir(src string, dst string) error {
+	return filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if fi.IsDir() {
+			return os.MkdirAll(target, 0o750)
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		return copyFile(path, target, fi.Mode())
+	})
+}
+
+func toSnapshotChainConfigs(configs map[string]*rpcpb.ChainConfig) map[string]snapshotChainConfig {
+	if len(configs) == 0 {
+		return nil
+	}
+	m := make(map[string]snapshotChainConfig, len(configs))
+	for chain, cc := range configs {
+		m[chain] = snapshotChainConfig{Config: cc.Config, Upgrade: cc.Upgrade}
+	}
+	return m
+}
+
+func fromSnapshotChainConfigs(m map[string]snapshotChainConfig) map[string]*rpcpb.ChainConfig {
+	if len(m) == 0 {
+		return nil
+	}
+	configs := make(map[string]*rpcpb.ChainConfig, len(m))
+	for chain, cc := range m {
+		configs[chain] = &rpcpb.ChainConfig{Config: cc.Config, Upgrade: cc.Upgrade}
+	}
+	return configs
+}
+
+func dirExists(dir string) bool {
+	fi, err := os.Stat(dir)
+	return err == nil && fi.IsDir()
+}
+
+func copyFile(src string, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_RDWR|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
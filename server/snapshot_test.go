@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSnapshotPaths(t *testing.T) {
+	execPath := newFakeBinary(t)
+	chainConfigs := map[string]*rpcpb.ChainConfig{"C": {Config: `{"pruning-enabled":false}`}}
+	lc := newTestNetwork(t, execPath, 2, chainConfigs)
+	lc.restartPolicy = &rpcpb.RestartPolicy{Type: rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ALWAYS}
+	lc.portRange = &rpcpb.PortRange{Start: 9650, End: 9700}
+	lc.nodeInfos["node1"].RestartPolicy = &rpcpb.RestartPolicy{
+		Type:       rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ON_FAILURE,
+		MaxRetries: 3,
+	}
+	if err := ioutil.WriteFile(filepath.Join(lc.nodeInfos["node1"].DbDir, "data"), []byte("db"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "snap")
+	if err := lc.saveSnapshot(dir); err != nil {
+		t.Fatal(err)
+	}
+	names, err := listSnapshots(filepath.Dir(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{"snap"}) {
+		t.Fatalf("unexpected snapshots %v", names)
+	}
+
+	rootDataDir := t.TempDir()
+	loaded, err := newNetworkFromSnapshot(dir, localNetworkOptions{
+		rootDataDir: rootDataDir,
+		ports:       newPortAllocator(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(loaded.logger.Stop)
+
+	if !reflect.DeepEqual(loaded.nodeNames, lc.nodeNames) {
+		t.Fatalf("expected nodes %v, got %v", lc.nodeNames, loaded.nodeNames)
+	}
+	for _, name := range loaded.nodeNames {
+		info, saved := loaded.nodeInfos[name], lc.nodeInfos[name]
+		// the directories are moved under the new root data directory
+		for _, dir := range []string{info.LogDir, info.DbDir, info.ChainConfigDir} {
+			if !strings.HasPrefix(dir, rootDataDir+string(filepath.Separator)) {
+				t.Fatalf("node %q: %q not under %q", name, dir, rootDataDir)
+			}
+		}
+		if info.ChainConfigDir != chainConfigDir(rootDataDir, name) {
+			t.Fatalf("node %q: unexpected chain config dir %q", name, info.ChainConfigDir)
+		}
+		if info.Id != saved.Id || info.ExecPath != execPath {
+			t.Fatalf("node %q: unexpected node info %+v", name, info)
+		}
+		if !reflect.DeepEqual(info.ChainConfigs, saved.ChainConfigs) {
+			t.Fatalf("node %q: expected chain configs %v, got %v", name, saved.ChainConfigs, info.ChainConfigs)
+		}
+	}
+	if p := loaded.nodeInfos["node1"].RestartPolicy; p.GetMaxRetries() != 3 {
+		t.Fatalf("restart policy not restored %v", p)
+	}
+	if !proto.Equal(loaded.restartPolicy, lc.restartPolicy) || !proto.Equal(loaded.portRange, lc.portRange) {
+		t.Fatalf("cluster defaults not restored %v, %v", loaded.restartPolicy, loaded.portRange)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(loaded.nodeInfos["node1"].DbDir, "data"))
+	if err != nil || string(b) != "db" {
+		t.Fatalf("database not copied: %q, %v", b, err)
+	}
+}
+
+func TestSnapshotDbDirOutsideRoot(t *testing.T) {
+	lc := newTestNetwork(t, newFakeBinary(t), 1, nil)
+	// a sibling of the root data directory, with the same prefix
+	dbDir := lc.rootDataDir + "-db"
+	t.Cleanup(func() {
+		os.RemoveAll(dbDir)
+	})
+	configFile, err := mergeConfig(nil, string(lc.cfg.NodeConfigs[0].ConfigFile), fmt.Sprintf(`{"db-dir":%q}`, dbDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lc.cfg.NodeConfigs[0].ConfigFile = configFile
+	if err := updateNodeInfo(lc.nodeInfos["node1"], configFile); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(dbDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dbDir, "data"), []byte("db"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(t.TempDir(), "snap")
+	if err := lc.saveSnapshot(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	// the database is moved under the new root data directory
+	rootDataDir := t.TempDir()
+	loaded, err := newNetworkFromSnapshot(dir, localNetworkOptions{
+		rootDataDir: rootDataDir,
+		ports:       newPortAllocator(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(loaded.logger.Stop)
+	info := loaded.nodeInfos["node1"]
+	if info.DbDir != filepath.Join(rootDataDir, "node1", "db-dir") {
+		t.Fatalf("unexpected db-dir %q", info.DbDir)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(info.DbDir, "data"))
+	if err != nil || string(b) != "db" {
+		t.Fatalf("database not copied: %q, %v", b, err)
+	}
+}
+
+func TestRelDir(t *testing.T) {
+	tests := []struct {
+		path string
+		rel  string
+		ok   bool
+	}{
+		{path: "/data/root/node1/db-dir", rel: "node1/db-dir", ok: true},
+		{path: "/data/root", rel: ".", ok: true},
+		{path: "/data/root2/node1/db-dir", ok: false},
+		{path: "/data/root/../other", ok: false},
+		{path: "/data", ok: false},
+		{path: "..data", ok: false},
+	}
+	for i, tv := range tests {
+		rel, ok := relDir("/data/root", tv.path)
+		if rel != tv.rel || ok != tv.ok {
+			t.Fatalf("#%d: expected %q, %v, got %q, %v", i, tv.rel, tv.ok, rel, ok)
+		}
+	}
+}
+
+func TestSnapshotExecPathOverride(t *testing.T) {
+	lc := newTestNetwork(t, newFakeBinary(t), 1, nil)
+	dir := filepath.Join(t.TempDir(), "snap")
+	if err := lc.saveSnapshot(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	execPath := newFakeBinary(t)
+	loaded, err := newNetworkFromSnapshot(dir, localNetworkOptions{
+		execPath:    execPath,
+		rootDataDir: t.TempDir(),
+		ports:       newPortAllocator(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(loaded.logger.Stop)
+	if p := loaded.nodeInfos["node1"].ExecPath; p != execPath {
+		t.Fatalf("expected %q, got %q", execPath, p)
+	}
+
+	if err := os.Remove(execPath); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newNetworkFromSnapshot(dir, localNetworkOptions{
+		execPath:    execPath,
+		rootDataDir: t.TempDir(),
+	}); err != ErrNotExists {
+		t.Fatalf("expected %v, got %v", ErrNotExists, err)
+	}
+}
+
+func TestCheckSnapshotName(t *testing.T) {
+	for _, name := range []string{"snap", "snap-1", "SNAP_2"} {
+		if err := checkSnapshotName(name); err != nil {
+			t.Fatalf("%q: unexpected error %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "..", "a/b", "snap 1"} {
+		if err := checkSnapshotName(name); err != ErrInvalidSnapshotName {
+			t.Fatalf("%q: expected %v, got %v", name, ErrInvalidSnapshotName, err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanche-network-runner/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/gyuho/avax-tester/rpcpb"
+)
+
+var (
+	ErrInvalidStakingKey = errors.New("invalid staking key")
+	ErrDuplicateNodeID   = errors.New("duplicate node ID")
+)
+
+// setStakingKey sets the staking key pair of [spec] in [cfg], if given,
+// and returns the node ID.
+func setStakingKey(cfg *node.Config, spec *rpcpb.NodeSpec) (string, error) {
+	cert, key := spec.GetStakingCert(), spec.GetStakingKey()
+	if cert != "" || key != "" {
+		if cert == "" || key == "" {
+			return "", fmt.Errorf("%w: both cert and key are required", ErrInvalidStakingKey)
+		}
+		cfg.StakingCert, cfg.StakingKey = []byte(cert), []byte(key)
+	}
+	nodeID, err := utils.ToNodeID(cfg.StakingKey, cfg.StakingCert)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidStakingKey, err)
+	}
+	return nodeID.PrefixedString(constants.NodeIDPrefix), nil
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/ava-labs/avalanche-network-runner/network/node"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/gyuho/avax-tester/rpcpb"
+)
+
+func TestSetStakingKey(t *testing.T) {
+	cert, key, err := staking.NewCertAndKeyBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaults := local.NewDefaultConfig("avalanchego").NodeConfigs[0]
+
+	// the default key pair is kept without a spec
+	cfg := defaults
+	defaultID, err := setStakingKey(&cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(cfg.StakingKey) != string(defaults.StakingKey) {
+		t.Fatal("default staking key replaced")
+	}
+
+	cfg = defaults
+	nodeID, err := setStakingKey(&cfg, &rpcpb.NodeSpec{StakingCert: string(cert), StakingKey: string(key)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodeID == defaultID || string(cfg.StakingCert) != string(cert) || string(cfg.StakingKey) != string(key) {
+		t.Fatalf("staking key not set, node ID %q", nodeID)
+	}
+
+	for _, spec := range []*rpcpb.NodeSpec{
+		{StakingCert: string(cert)},
+		{StakingKey: string(key)},
+		{StakingCert: "invalid", StakingKey: "invalid"},
+		// mismatched pair from another node
+		{StakingCert: string(defaults.StakingCert), StakingKey: string(key)},
+	} {
+		cfg := node.Config{}
+		if _, err := setStakingKey(&cfg, spec); !errors.Is(err, ErrInvalidStakingKey) {
+			t.Fatalf("expected %v, got %v", ErrInvalidStakingKey, err)
+		}
+	}
+}
+
+func TestNewNetworkDuplicateNodeID(t *testing.T) {
+	cert, key, err := staking.NewCertAndKeyBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := &rpcpb.NodeSpec{StakingCert: string(cert), StakingKey: string(key)}
+	_, err = newNetwork(localNetworkOptions{
+		execPath:    newFakeBinary(t),
+		rootDataDir: t.TempDir(),
+		numNodes:    2,
+		nodeSpecs:   []*rpcpb.NodeSpec{spec, spec},
+		ports:       newPortAllocator(),
+		clusterName: DefaultClusterName,
+	})
+	if !errors.Is(err, ErrDuplicateNodeID) {
+		t.Fatalf("expected %v, got %v", ErrDuplicateNodeID, err)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError converts [err] to a gRPC status error with the code
+// matching the server error, so that clients can tell failures apart.
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := codes.Internal
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled),
+		errors.Is(err, ErrStatusCanceled):
+		code = codes.Canceled
+	case errors.Is(err, ErrNotBootstrapped),
+		errors.Is(err, ErrNodeNotFound),
+		errors.Is(err, ErrNotExists),
+		errors.Is(err, ErrSnapshotNotFound),
+		errors.Is(err, ErrOperationNotFound),
+		errors.Is(err, ErrProcessNotFound),
+		errors.Is(err, ErrLogNotFound),
+		errors.Is(err, ErrSubnetNotFound),
+		errors.Is(err, ErrVMNotFound):
+		code = codes.NotFound
+	case errors.Is(err, ErrAlreadyBootstrapped),
+		errors.Is(err, ErrDuplicateNode),
+		errors.Is(err, ErrDuplicateNodeID),
+		errors.Is(err, ErrSnapshotExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, ErrInvalidClusterName),
+		errors.Is(err, ErrInvalidSnapshotName),
+		errors.Is(err, ErrInvalidNumNodes),
+		errors.Is(err, ErrTooManyNodeSpecs),
+		errors.Is(err, ErrInvalidConfig),
+		errors.Is(err, ErrNoStakers),
+		errors.Is(err, ErrInvalidGenesis),
+		errors.Is(err, ErrUnknownStaker),
+		errors.Is(err, ErrInvalidLogStream),
+		errors.Is(err, ErrInvalidPortRange),
+		errors.Is(err, ErrInvalidStakingKey),
+		errors.Is(err, ErrInvalidVM),
+		errors.Is(err, ErrInvalidChainConfig),
+		errors.Is(err, ErrInvalidWaitCondition):
+		code = codes.InvalidArgument
+	case errors.Is(err, ErrNotHealthy),
+		errors.Is(err, ErrNoAPIClient),
+		errors.Is(err, ErrNoSubnetNodes),
+		errors.Is(err, ErrNodePaused),
+		errors.Is(err, ErrNodeNotPaused):
+		code = codes.FailedPrecondition
+	case errors.Is(err, ErrNoFreePort):
+		code = codes.ResourceExhausted
+	case errors.Is(err, ErrCompacted):
+		code = codes.OutOfRange
+	case errors.Is(err, errAborted):
+		code = codes.Aborted
+	case errors.Is(err, ErrClosed):
+		code = codes.Unavailable
+	case errors.Is(err, ErrPartitionUnsupported),
+		errors.Is(err, ErrLinkConditionsUnsupported):
+		code = codes.Unimplemented
+	}
+	return status.Error(code, err.Error())
+}
+
+func unaryStatusInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatusError(err)
+}
+
+func streamStatusInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatusError(handler(srv, ss))
+}
@@ -0,0 +1,319 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/gyuho/avax-tester/pkg/color"
+	"github.com/gyuho/avax-tester/rpcpb"
+	"go.uber.org/zap"
+)
+
+const (
+	subnetValidatorWeight = 1000
+	// ends before the primary network validation periods of the
+	// validators added by the server
+	subnetValidatorStakeDuration = 7 * 24 * time.Hour
+
+	pChainRequestTimeout = 10 * time.Second
+)
+
+var ErrSubnetNotFound = errors.New("subnet not found")
+
+// createSubnet creates a subnet controlled by the pre-funded key, and
+// returns its ID. Assumes the network is healthy.
+func (lc *localNetwork) createSubnet(ctx context.Context) (string, error) {
+	cli, addr, err := lc.pChainWallet()
+	if err != nil {
+		return "", err
+	}
+	color.Outf("{{blue}}creating subnet{{/}}\n")
+	txID, err := cli.PChainAPI().CreateSubnet(ewoqUser, []string{addr}, addr, []string{addr}, 1)
+	if err != nil {
+		return "", err
+	}
+	if err := waitForTxCommitted(ctx, cli.PChainAPI(), txID); err != nil {
+		return "", err
+	}
+	subnetID := txID.String()
+	lc.subnetIDs = append(lc.subnetIDs, subnetID)
+	color.Outf("{{green}}created subnet %q{{/}}\n", subnetID)
+	return subnetID, nil
+}
+
+// addSubnetValidators whitelists [subnetID] in the nodes in [nodeNames]
+// (all nodes, if empty), restarting them one at a time, and adds them as
+// the subnet validators with the pre-funded key. The nodes must be primary
+// network validators. Waits until all of them validate the subnet.
+func (lc *localNetwork) addSubnetValidators(ctx context.Context, subnetID string, nodeNames []string) error {
+	id, err := ids.FromString(subnetID)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrSubnetNotFound, subnetID)
+	}
+	if len(nodeNames) == 0 {
+		nodeNames = lc.nodeNames
+	}
+	for _, name := range nodeNames {
+		if _, ok := lc.nodeInfos[name]; !ok {
+			return fmt.Errorf("%w: %q", ErrNodeNotFound, name)
+		}
+	}
+	cli, addr, err := lc.pChainWallet()
+	if err != nil {
+		return err
+	}
+	subnets, err := cli.PChainAPI().GetSubnets([]ids.ID{id})
+	if err != nil {
+		return err
+	}
+	if len(subnets) == 0 {
+		return fmt.Errorf("%w: %q", ErrSubnetNotFound, subnetID)
+	}
+
+	for _, name := range nodeNames {
+		nodeInfo := lc.nodeInfos[name]
+		if isWhitelisted(nodeInfo.WhitelistedSubnets, subnetID) {
+			continue
+		}
+		whitelist := subnetID
+		if nodeInfo.WhitelistedSubnets != "" {
+			whitelist = nodeInfo.WhitelistedSubnets + "," + subnetID
+		}
+		color.Outf("{{blue}}whitelisting subnet %q in %q{{/}}\n", subnetID, name)
+		if err := lc.restartNode(ctx, name, "", whitelist, ""); err != nil {
+			return err
+		}
+	}
+
+	current, err := cli.PChainAPI().GetCurrentValidators(id, nil)
+	if err != nil {
+		return err
+	}
+	_, pending, err := cli.PChainAPI().GetPendingValidators(id, nil)
+	if err != nil {
+		return err
+	}
+	added := make(map[string]struct{})
+	for _, nodeID := range append(validatorNodeIDs(current), validatorNodeIDs(pending)...) {
+		added[nodeID] = struct{}{}
+	}
+
+	start := time.Now().Add(validatorStartDelay)
+	end := start.Add(subnetValidatorStakeDuration)
+	nodeIDs := make([]ids.ShortID, 0, len(nodeNames))
+	for _, name := range nodeNames {
+		nodeID := lc.nodeInfos[name].Id
+		shortID, err := ids.ShortFromPrefixedString(nodeID, constants.NodeIDPrefix)
+		if err != nil {
+			return err
+		}
+		nodeIDs = append(nodeIDs, shortID)
+		if _, ok := added[nodeID]; ok {
+			continue
+		}
+		color.Outf("{{blue}}adding %q to subnet %q{{/}}\n", nodeID, subnetID)
+		txID, err := cli.PChainAPI().AddSubnetValidator(
+			ewoqUser,
+			[]string{addr},
+			addr,
+			subnetID,
+			nodeID,
+			subnetValidatorWeight,
+			uint64(start.Unix()),
+			uint64(end.Unix()),
+		)
+		if err != nil {
+			return fmt.Errorf("node %q: %w", name, err)
+		}
+		if err := waitForTxCommitted(ctx, cli.PChainAPI(), txID); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, validatorStartDelay+txWait)
+	defer cancel()
+	for {
+		vdrs, err := cli.PChainAPI().GetCurrentValidators(id, nodeIDs)
+		if err == nil && len(vdrs) == len(nodeIDs) {
+			color.Outf("{{green}}%d nodes now validate subnet %q{{/}}\n", len(nodeIDs), subnetID)
+			return nil
+		}
+		select {
+		case <-lc.stopc:
+			return errAborted
+		case <-ctx.Done():
+			return fmt.Errorf("nodes did not become subnet %q validators: %w", subnetID, ctx.Err())
+		case <-time.After(txPollInterval):
+		}
+	}
+}
+
+func isWhitelisted(whitelistedSubnets string, subnetID string) bool {
+	for _, id := range strings.Split(whitelistedSubnets, ",") {
+		if strings.TrimSpace(id) == subnetID {
+			return true
+		}
+	}
+	return false
+}
+
+// validatorNodeIDs returns the node IDs in the "platform.getCurrentValidators"
+// or "platform.getPendingValidators" reply.
+func validatorNodeIDs(vdrs []interface{}) []string {
+	nodeIDs := make([]string, 0, len(vdrs))
+	for _, v := range vdrs {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if nodeID, ok := m["nodeID"].(string); ok {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	return nodeIDs
+}
+
+func (s *server) CreateSubnet(ctx context.Context, req *rpcpb.CreateSubnetRequest) (*rpcpb.CreateSubnetResponse, error) {
+	zap.L().Debug("received create subnet request")
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNodeNames(c.getInfo(), req.GetNodeNames()); err != nil {
+		return nil, err
+	}
+
+	op := s.ops.run(s.rootCtx, "CreateSubnet", c, func(ctx context.Context, o *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
+
+		subnetID, err := nw.createSubnet(ctx)
+		if err != nil {
+			return err
+		}
+		c.publish(nw)
+		o.update(func(op *rpcpb.Operation) {
+			op.SubnetId = subnetID
+		})
+		err = nw.addSubnetValidators(ctx, subnetID, req.GetNodeNames())
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.CreateSubnetResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
+
+func (s *server) AddSubnetValidators(ctx context.Context, req *rpcpb.AddSubnetValidatorsRequest) (*rpcpb.AddSubnetValidatorsResponse, error) {
+	zap.L().Debug("received add subnet validators request", zap.String("subnet-id", req.GetSubnetId()))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ids.FromString(req.GetSubnetId()); err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrSubnetNotFound, req.GetSubnetId())
+	}
+	if err := checkNodeNames(c.getInfo(), req.GetNodeNames()); err != nil {
+		return nil, err
+	}
+
+	op := s.ops.run(s.rootCtx, "AddSubnetValidators", c, func(ctx context.Context, _ *operation) error {
+		nw, err := c.lockStartedNetwork(ctx)
+		if err != nil {
+			return err
+		}
+		defer c.unlockNetwork()
+
+		err = nw.addSubnetValidators(ctx, req.GetSubnetId(), req.GetNodeNames())
+		c.publish(nw)
+		return err
+	})
+	return &rpcpb.AddSubnetValidatorsResponse{ClusterInfo: c.getInfo(), Operation: op}, nil
+}
+
+// ListSubnets queries the P-chain through a healthy node, without the
+// operation lock.
+func (s *server) ListSubnets(ctx context.Context, req *rpcpb.ListSubnetsRequest) (*rpcpb.ListSubnetsResponse, error) {
+	zap.L().Debug("received list subnets request")
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	info := c.getInfo()
+	if info == nil {
+		return nil, ErrNotBootstrapped
+	}
+	cli, err := pChainClient(info)
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := cli.GetSubnets(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(info.NodeInfos))
+	for name, nodeInfo := range info.NodeInfos {
+		names[nodeInfo.Id] = name
+	}
+	resp := &rpcpb.ListSubnetsResponse{}
+	for _, subnet := range subnets {
+		if subnet.ID == constants.PrimaryNetworkID {
+			continue
+		}
+		vdrs, err := cli.GetCurrentValidators(subnet.ID, nil)
+		if err != nil {
+			return nil, err
+		}
+		si := &rpcpb.SubnetInfo{
+			Id:               subnet.ID.String(),
+			ControlKeys:      subnet.ControlKeys,
+			Threshold:        uint32(subnet.Threshold),
+			ValidatorNodeIds: validatorNodeIDs(vdrs),
+		}
+		sort.Strings(si.ValidatorNodeIds)
+		for _, nodeID := range si.ValidatorNodeIds {
+			if name, ok := names[nodeID]; ok {
+				si.ValidatorNodeNames = append(si.ValidatorNodeNames, name)
+			}
+		}
+		resp.Subnets = append(resp.Subnets, si)
+	}
+	return resp, nil
+}
+
+// pChainClient returns a P-chain client of a healthy node in [info].
+func pChainClient(info *rpcpb.ClusterInfo) (platformvm.Client, error) {
+	for _, name := range info.NodeNames {
+		nodeInfo := info.NodeInfos[name]
+		if nodeInfo.Uri != "" && nodeInfo.Healthy {
+			return platformvm.NewClient(nodeInfo.Uri, pChainRequestTimeout), nil
+		}
+	}
+	return nil, ErrNoAPIClient
+}
+
+// checkNodeNames returns ErrNodeNotFound if a node in [nodeNames]
+// is not in the cluster.
+func checkNodeNames(info *rpcpb.ClusterInfo, nodeNames []string) error {
+	if info == nil {
+		return ErrNotBootstrapped
+	}
+	for _, name := range nodeNames {
+		if _, ok := info.NodeInfos[name]; !ok {
+			return fmt.Errorf("%w: %q", ErrNodeNotFound, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gyuho/avax-tester/pkg/color"
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultInitialRestartBackoff = time.Second
+	defaultMaxRestartBackoff     = time.Minute
+)
+
+type supervisedNode struct {
+	policy *rpcpb.RestartPolicy
+
+	// restarts since the node was last healthy
+	retries uint32
+	backoff time.Duration
+	timer   *time.Timer
+
+	crashes   uint32
+	lastCrash int64
+	gaveUp    bool
+}
+
+// supervisor restarts the exited node processes, following their
+// restart policies. The node monitor reports the exits.
+type supervisor struct {
+	emit func(*rpcpb.Event)
+	// restarts the node with the operation lock; set before the nodes start
+	restart func(name string)
+
+	mu     sync.Mutex
+	closed bool
+	nodes  map[string]*supervisedNode
+}
+
+func newSupervisor(emit func(*rpcpb.Event)) *supervisor {
+	return &supervisor{
+		emit:  emit,
+		nodes: make(map[string]*supervisedNode),
+	}
+}
+
+// track starts supervising the new node.
+func (sv *supervisor) track(name string, policy *rpcpb.RestartPolicy) {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	if n, ok := sv.nodes[name]; ok && n.timer != nil {
+		n.timer.Stop()
+	}
+	sv.nodes[name] = &supervisedNode{policy: policy}
+}
+
+func (sv *supervisor) untrack(name string) {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	if n, ok := sv.nodes[name]; ok && n.timer != nil {
+		n.timer.Stop()
+	}
+	delete(sv.nodes, name)
+}
+
+// close stops the scheduled restarts.
+func (sv *supervisor) close() {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	sv.closed = true
+	for _, n := range sv.nodes {
+		if n.timer != nil {
+			n.timer.Stop()
+		}
+	}
+}
+
+// onExit records the crash, and schedules a restart if the policy says so.
+func (sv *supervisor) onExit(name string, exitCode int32) {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	n, ok := sv.nodes[name]
+	if !ok || sv.closed {
+		return
+	}
+	n.crashes++
+	n.lastCrash = time.Now().UnixNano()
+
+	switch n.policy.GetType() {
+	case rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ALWAYS:
+	case rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ON_FAILURE:
+		if exitCode == 0 {
+			return
+		}
+	default:
+		return
+	}
+	sv.schedule(name, n)
+}
+
+// onRestartFailed schedules the next restart.
+func (sv *supervisor) onRestartFailed(name string) {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	n, ok := sv.nodes[name]
+	if !ok || sv.closed {
+		return
+	}
+	sv.schedule(name, n)
+}
+
+// onHealthy resets the retries once the node is healthy.
+func (sv *supervisor) onHealthy(name string) {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	n, ok := sv.nodes[name]
+	if !ok {
+		return
+	}
+	n.retries, n.backoff = 0, 0
+}
+
+// schedule restarts the node after the backoff, unless it reached
+// the max retries. Assumes [sv.mu] is held.
+func (sv *supervisor) schedule(name string, n *supervisedNode) {
+	if max := n.policy.GetMaxRetries(); max > 0 && n.retries >= max {
+		n.gaveUp = true
+		color.Outf("{{red}}{{bold}}node %q exited, gave up restarting after %d retries{{/}}\n", name, n.retries)
+		sv.emit(&rpcpb.Event{
+			Type:     rpcpb.EventType_EVENT_TYPE_NODE_RESTART_GAVE_UP,
+			NodeName: name,
+			Message:  fmt.Sprintf("reached max retries %d", max),
+		})
+		return
+	}
+
+	if n.backoff == 0 {
+		n.backoff = time.Duration(n.policy.GetInitialBackoff())
+		if n.backoff == 0 {
+			n.backoff = defaultInitialRestartBackoff
+		}
+	}
+	delay := n.backoff
+	maxBackoff := time.Duration(n.policy.GetMaxBackoff())
+	if maxBackoff == 0 {
+		maxBackoff = defaultMaxRestartBackoff
+	}
+	if n.backoff *= 2; n.backoff > maxBackoff {
+		n.backoff = maxBackoff
+	}
+	n.retries++
+	n.gaveUp = false
+
+	color.Outf("{{yellow}}{{bold}}restarting exited node %q in %v (retry %d){{/}}\n", name, delay, n.retries)
+	n.timer = time.AfterFunc(delay, func() {
+		sv.mu.Lock()
+		ok := !sv.closed && sv.nodes[name] == n
+		sv.mu.Unlock()
+		if ok {
+			sv.restart(name)
+		}
+	})
+}
+
+// apply sets the crash records of the nodes in [infos],
+// which are copies owned by the caller.
+func (sv *supervisor) apply(infos map[string]*rpcpb.NodeInfo) {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	for name, info := range infos {
+		n, ok := sv.nodes[name]
+		if !ok {
+			continue
+		}
+		info.CrashCount = n.crashes
+		info.LastCrashTime = n.lastCrash
+		info.RestartGaveUp = n.gaveUp
+	}
+}
+
+// autoRestart restarts the exited node for the supervisor, with the same
+// binary and config. No-op if the node was removed or restarted since.
+func (lc *localNetwork) autoRestart(name string) error {
+	nodeInfo, ok := lc.nodeInfos[name]
+	if !ok || !lc.monitor.exited(name) {
+		return nil
+	}
+	if err := lc.replaceNode(name, "", nodeInfo.WhitelistedSubnets, ""); err != nil {
+		lc.supervisor.onRestartFailed(name)
+		return err
+	}
+	return nil
+}
+
+// mergeRestartPolicy returns [policy] if set, [def] otherwise.
+func mergeRestartPolicy(def *rpcpb.RestartPolicy, policy *rpcpb.RestartPolicy) *rpcpb.RestartPolicy {
+	if policy == nil {
+		policy = def
+	}
+	if policy == nil {
+		return nil
+	}
+	return proto.Clone(policy).(*rpcpb.RestartPolicy)
+}
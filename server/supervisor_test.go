@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/local"
+	"github.com/gyuho/avax-tester/rpcpb"
+)
+
+func TestSupervisorRestartPolicy(t *testing.T) {
+	var evs []*rpcpb.Event
+	sv := newSupervisor(func(ev *rpcpb.Event) { evs = append(evs, ev) })
+	sv.restart = func(string) {}
+	defer sv.close()
+
+	// timers do not fire during the test
+	sv.track("node1", &rpcpb.RestartPolicy{
+		Type:           rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ON_FAILURE,
+		MaxRetries:     2,
+		InitialBackoff: int64(time.Hour),
+		MaxBackoff:     int64(3 * time.Hour),
+	})
+	n := sv.nodes["node1"]
+
+	sv.onExit("node1", 0)
+	if n.crashes != 1 || n.retries != 0 {
+		t.Fatalf("clean exit: crashes %d, retries %d", n.crashes, n.retries)
+	}
+	sv.onExit("node1", 1)
+	if n.retries != 1 || n.backoff != 2*time.Hour {
+		t.Fatalf("first retry: retries %d, backoff %v", n.retries, n.backoff)
+	}
+	sv.onRestartFailed("node1")
+	if n.retries != 2 || n.backoff != 3*time.Hour {
+		t.Fatalf("second retry: retries %d, backoff %v", n.retries, n.backoff)
+	}
+	sv.onRestartFailed("node1")
+	if !n.gaveUp || n.retries != 2 {
+		t.Fatalf("max retries: gave up %v, retries %d", n.gaveUp, n.retries)
+	}
+	if len(evs) != 1 || evs[0].Type != rpcpb.EventType_EVENT_TYPE_NODE_RESTART_GAVE_UP {
+		t.Fatalf("unexpected events %v", evs)
+	}
+
+	sv.onHealthy("node1")
+	if n.retries != 0 || n.backoff != 0 {
+		t.Fatalf("healthy: retries %d, backoff %v", n.retries, n.backoff)
+	}
+
+	infos := map[string]*rpcpb.NodeInfo{"node1": {}, "node2": {}}
+	sv.apply(infos)
+	if infos["node1"].CrashCount != 2 || !infos["node1"].RestartGaveUp || infos["node2"].CrashCount != 0 {
+		t.Fatalf("unexpected node infos %v", infos)
+	}
+}
+
+func TestSupervisorNoPolicy(t *testing.T) {
+	sv := newSupervisor(func(*rpcpb.Event) {})
+	defer sv.close()
+	sv.track("node1", nil)
+	sv.onExit("node1", 1)
+	if n := sv.nodes["node1"]; n.crashes != 1 || n.retries != 0 || n.timer != nil {
+		t.Fatalf("restart scheduled without a policy: %+v", n)
+	}
+}
+
+func TestMergeRestartPolicy(t *testing.T) {
+	def := &rpcpb.RestartPolicy{Type: rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ALWAYS}
+	node := &rpcpb.RestartPolicy{Type: rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ON_FAILURE}
+	if p := mergeRestartPolicy(def, nil); p.Type != def.Type || p == def {
+		t.Fatalf("expected a copy of the default, got %v", p)
+	}
+	if p := mergeRestartPolicy(def, node); p.Type != node.Type {
+		t.Fatalf("expected the node policy, got %v", p)
+	}
+	if p := mergeRestartPolicy(nil, nil); p != nil {
+		t.Fatalf("expected no policy, got %v", p)
+	}
+}
+
+func TestAutoRestartRetriesFailedRelaunch(t *testing.T) {
+	execPath := newFakeBinary(t)
+	lc := newTestNetwork(t, execPath, 1, nil)
+	nw, err := local.NewNetwork(lc.logger, lc.cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lc.nw = nw
+	t.Cleanup(func() {
+		lc.supervisor.close()
+		_ = nw.Stop(context.Background())
+	})
+
+	name := lc.nodeNames[0]
+	var mu sync.Mutex
+	attempts := 0
+	lc.supervisor.restart = func(name string) {
+		// serialized as with the operation lock
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if err := lc.autoRestart(name); err == nil {
+			t.Errorf("attempt %d: relaunch did not fail", attempts)
+		}
+	}
+	lc.supervisor.track(name, &rpcpb.RestartPolicy{
+		Type:           rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ALWAYS,
+		MaxRetries:     3,
+		InitialBackoff: int64(time.Millisecond),
+	})
+	lc.monitor.track(name, lc.nodeInfos[name].DbDir, nil)
+
+	// the binary can no longer start, and the process exits
+	if err := os.Chmod(execPath, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	lc.monitor.update([]*nodeCheck{{name: name, node: lc.monitor.nodes[name], exited: true, exitCode: 1}})
+
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		infos := map[string]*rpcpb.NodeInfo{name: {}}
+		lc.supervisor.apply(infos)
+		if infos[name].RestartGaveUp {
+			break
+		}
+		if time.Now().After(deadline) {
+			mu.Lock()
+			t.Fatalf("restart abandoned after %d attempts", attempts)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+	if !lc.monitor.exited(name) {
+		t.Fatal("node not reported as exited")
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gyuho/avax-tester/pkg/color"
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/protobuf/proto"
+)
+
+type rollingUpgradeOptions struct {
+	execPath string
+	// upgrade order
+	nodeNames      []string
+	maxUnavailable int
+	healthTimeout  time.Duration
+}
+
+// newRollingUpgradeOptions validates the request against the cluster
+// nodes, and fills in the defaults.
+func newRollingUpgradeOptions(req *rpcpb.RollingUpgradeRequest, info *rpcpb.ClusterInfo) (rollingUpgradeOptions, error) {
+	opts := rollingUpgradeOptions{
+		execPath:       req.GetExecPath(),
+		nodeNames:      req.GetNodeNames(),
+		maxUnavailable: int(req.GetMaxUnavailable()),
+		healthTimeout:  time.Duration(req.GetHealthTimeout()),
+	}
+	if _, err := os.Stat(opts.execPath); err != nil {
+		return opts, ErrNotExists
+	}
+	if len(opts.nodeNames) == 0 {
+		opts.nodeNames = info.NodeNames
+	}
+	seen := make(map[string]struct{}, len(opts.nodeNames))
+	for _, name := range opts.nodeNames {
+		if _, ok := info.NodeInfos[name]; !ok {
+			return opts, fmt.Errorf("%w: %q", ErrNodeNotFound, name)
+		}
+		if _, ok := seen[name]; ok {
+			return opts, fmt.Errorf("%w: %q", ErrDuplicateNode, name)
+		}
+		seen[name] = struct{}{}
+	}
+	if opts.maxUnavailable == 0 {
+		opts.maxUnavailable = 1
+	}
+	if opts.healthTimeout == 0 {
+		opts.healthTimeout = healthyWait
+	}
+	return opts, nil
+}
+
+// rollingUpgrade restarts the nodes with [opts.execPath], at most
+// [opts.maxUnavailable] at a time in [opts.nodeNames] order, keeping
+// their staking keys, databases and configs. Each step must bring the
+// network back to healthy within [opts.healthTimeout], otherwise the
+// upgrade stops with the remaining nodes left on their old binaries.
+// [progress] is called with a copy of the report after each change.
+func (lc *localNetwork) rollingUpgrade(ctx context.Context, opts rollingUpgradeOptions, progress func(*rpcpb.RollingUpgradeReport)) error {
+	report := &rpcpb.RollingUpgradeReport{ExecPath: opts.execPath}
+	for _, name := range opts.nodeNames {
+		info, ok := lc.nodeInfos[name]
+		if !ok {
+			return ErrNodeNotFound
+		}
+		report.Nodes = append(report.Nodes, &rpcpb.NodeUpgrade{
+			Name:        name,
+			OldExecPath: info.ExecPath,
+			State:       rpcpb.UpgradeState_UPGRADE_STATE_PENDING,
+		})
+	}
+	progress(proto.Clone(report).(*rpcpb.RollingUpgradeReport))
+
+	// do not take down more nodes from an unhealthy network
+	if err := lc.waitForHealthyWithin(ctx, opts.healthTimeout); err != nil {
+		return fmt.Errorf("network not healthy before upgrade: %w", err)
+	}
+
+	for start := 0; start < len(report.Nodes); start += opts.maxUnavailable {
+		end := start + opts.maxUnavailable
+		if end > len(report.Nodes) {
+			end = len(report.Nodes)
+		}
+
+		batch, names := make([]*rpcpb.NodeUpgrade, 0, end-start), make([]string, 0, end-start)
+		for _, nu := range report.Nodes[start:end] {
+			if nu.OldExecPath == opts.execPath {
+				nu.State = rpcpb.UpgradeState_UPGRADE_STATE_SKIPPED
+				continue
+			}
+			color.Outf("{{blue}}{{bold}}upgrading node %q to %q{{/}}\n", nu.Name, opts.execPath)
+			nu.StartTime = time.Now().UnixNano()
+			info := lc.nodeInfos[nu.Name]
+			err := lc.replaceNode(nu.Name, opts.execPath, info.WhitelistedSubnets, "")
+			if err != nil {
+				nu.State = rpcpb.UpgradeState_UPGRADE_STATE_FAILED
+				nu.Error = err.Error()
+				nu.EndTime = time.Now().UnixNano()
+				progress(proto.Clone(report).(*rpcpb.RollingUpgradeReport))
+				return fmt.Errorf("failed to upgrade node %q: %w", nu.Name, err)
+			}
+			batch = append(batch, nu)
+			names = append(names, nu.Name)
+		}
+		if len(batch) == 0 {
+			progress(proto.Clone(report).(*rpcpb.RollingUpgradeReport))
+			continue
+		}
+
+		err := lc.waitForHealthyWithin(ctx, opts.healthTimeout)
+		now := time.Now().UnixNano()
+		for _, nu := range batch {
+			nu.EndTime = now
+			if err != nil {
+				nu.State = rpcpb.UpgradeState_UPGRADE_STATE_FAILED
+				nu.Error = err.Error()
+				continue
+			}
+			nu.State = rpcpb.UpgradeState_UPGRADE_STATE_UPGRADED
+		}
+		progress(proto.Clone(report).(*rpcpb.RollingUpgradeReport))
+		if err != nil {
+			return fmt.Errorf("network not healthy after upgrading %q: %w", names, err)
+		}
+	}
+	color.Outf("{{green}}{{bold}}upgraded all nodes to %q{{/}}\n", opts.execPath)
+	return nil
+}
+
+func (lc *localNetwork) waitForHealthyWithin(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return lc.waitForHealthy(ctx)
+}
@@ -0,0 +1,258 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/gyuho/avax-tester/rpcpb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultWaitTimeout      = 5 * time.Minute
+	defaultWaitPollInterval = time.Second
+)
+
+var ErrInvalidWaitCondition = errors.New("invalid wait condition")
+
+// checkWaitConditions returns ErrInvalidWaitCondition if a condition
+// misses the fields of its type.
+func checkWaitConditions(conds []*rpcpb.WaitCondition) error {
+	if len(conds) == 0 {
+		return fmt.Errorf("%w: no condition", ErrInvalidWaitCondition)
+	}
+	for _, cond := range conds {
+		switch cond.GetType() {
+		case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_HEALTHY,
+			rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_C_CHAIN_HEIGHT,
+			rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_P_CHAIN_HEIGHT:
+		case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_NODE_COUNT:
+			if cond.GetNodeCount() == 0 {
+				return fmt.Errorf("%w: zero node count", ErrInvalidWaitCondition)
+			}
+		case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED:
+			if cond.GetChain() == "" {
+				return fmt.Errorf("%w: empty chain", ErrInvalidWaitCondition)
+			}
+		case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_VALIDATOR:
+			if cond.GetNodeId() == "" {
+				return fmt.Errorf("%w: empty node ID", ErrInvalidWaitCondition)
+			}
+			if cond.GetSubnetId() != "" {
+				if _, err := ids.FromString(cond.GetSubnetId()); err != nil {
+					return fmt.Errorf("%w: subnet ID %q", ErrInvalidWaitCondition, cond.GetSubnetId())
+				}
+			}
+		default:
+			return fmt.Errorf("%w: type %q", ErrInvalidWaitCondition, cond.GetType())
+		}
+	}
+	return nil
+}
+
+// checkWaitCondition returns whether [cond] holds in the cluster [ci],
+// with a message on the progress.
+func checkWaitCondition(ci *rpcpb.ClusterInfo, cond *rpcpb.WaitCondition) (bool, string) {
+	if ci == nil {
+		return false, "cluster not started"
+	}
+	switch cond.Type {
+	case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_HEALTHY:
+		n := countNodes(ci, func(nodeInfo *rpcpb.NodeInfo) bool { return nodeInfo.Healthy })
+		return n == len(ci.NodeNames), fmt.Sprintf("%d/%d nodes healthy", n, len(ci.NodeNames))
+
+	case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_NODE_COUNT:
+		n := countNodes(ci, func(nodeInfo *rpcpb.NodeInfo) bool { return nodeInfo.Healthy })
+		ok := len(ci.NodeNames) == int(cond.NodeCount) && n == len(ci.NodeNames)
+		return ok, fmt.Sprintf("%d nodes (%d healthy), want %d", len(ci.NodeNames), n, cond.NodeCount)
+
+	case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED:
+		n := countNodes(ci, func(nodeInfo *rpcpb.NodeInfo) bool {
+			cli := info.NewClient(nodeInfo.Uri, pChainRequestTimeout)
+			bootstrapped, err := cli.IsBootstrapped(cond.Chain)
+			return err == nil && bootstrapped
+		})
+		return n == len(ci.NodeNames), fmt.Sprintf("%d/%d nodes bootstrapped %q", n, len(ci.NodeNames), cond.Chain)
+
+	case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_C_CHAIN_HEIGHT:
+		n := countNodes(ci, func(nodeInfo *rpcpb.NodeInfo) bool {
+			height, err := cChainHeight(nodeInfo.Uri)
+			return err == nil && height >= cond.Height
+		})
+		return n == len(ci.NodeNames), fmt.Sprintf("%d/%d nodes at C-chain height %d", n, len(ci.NodeNames), cond.Height)
+
+	case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_P_CHAIN_HEIGHT:
+		n := countNodes(ci, func(nodeInfo *rpcpb.NodeInfo) bool {
+			height, err := platformvm.NewClient(nodeInfo.Uri, pChainRequestTimeout).GetHeight()
+			return err == nil && height >= cond.Height
+		})
+		return n == len(ci.NodeNames), fmt.Sprintf("%d/%d nodes at P-chain height %d", n, len(ci.NodeNames), cond.Height)
+
+	case rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_VALIDATOR:
+		nodeID := cond.NodeId
+		if nodeInfo, ok := ci.NodeInfos[nodeID]; ok {
+			nodeID = nodeInfo.Id
+		}
+		shortID, err := ids.ShortFromPrefixedString(nodeID, constants.NodeIDPrefix)
+		if err != nil {
+			return false, fmt.Sprintf("node %q: %v", cond.NodeId, err)
+		}
+		subnetID := constants.PrimaryNetworkID
+		if cond.SubnetId != "" {
+			if subnetID, err = ids.FromString(cond.SubnetId); err != nil {
+				return false, err.Error()
+			}
+		}
+		cli, err := pChainClient(ci)
+		if err != nil {
+			return false, err.Error()
+		}
+		vdrs, err := cli.GetCurrentValidators(subnetID, []ids.ShortID{shortID})
+		if err != nil {
+			return false, err.Error()
+		}
+		if len(vdrs) == 0 {
+			return false, fmt.Sprintf("%q not validating %q yet", nodeID, subnetID)
+		}
+		return true, fmt.Sprintf("%q validating %q", nodeID, subnetID)
+	}
+	return false, fmt.Sprintf("unknown condition %q", cond.Type)
+}
+
+// countNodes returns the number of nodes with an API endpoint for which
+// [f] returns true, calling [f] for the nodes concurrently.
+func countNodes(ci *rpcpb.ClusterInfo, f func(*rpcpb.NodeInfo) bool) int {
+	var (
+		wg sync.WaitGroup
+		n  int32
+	)
+	for _, name := range ci.NodeNames {
+		nodeInfo, ok := ci.NodeInfos[name]
+		if !ok || nodeInfo.Uri == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(nodeInfo *rpcpb.NodeInfo) {
+			defer wg.Done()
+			if f(nodeInfo) {
+				atomic.AddInt32(&n, 1)
+			}
+		}(nodeInfo)
+	}
+	wg.Wait()
+	return int(n)
+}
+
+// checkWaitRound checks all [conds] in the cluster [ci].
+func checkWaitRound(ci *rpcpb.ClusterInfo, conds []*rpcpb.WaitCondition) *rpcpb.WaitForResponse {
+	resp := &rpcpb.WaitForResponse{Satisfied: true}
+	for _, cond := range conds {
+		ok, msg := checkWaitCondition(ci, cond)
+		resp.Statuses = append(resp.Statuses, &rpcpb.WaitConditionStatus{Condition: cond, Satisfied: ok, Message: msg})
+		if !ok {
+			resp.Satisfied = false
+		}
+	}
+	return resp
+}
+
+// cChainHeight returns the last accepted C-chain block number of the node.
+func cChainHeight(uri string) (uint64, error) {
+	var height string
+	if err := rpc.NewRPCRequester(uri, pChainRequestTimeout).SendJSONRPCRequest(
+		"/ext/bc/C/rpc",
+		"eth_blockNumber",
+		[]interface{}{},
+		&height,
+	); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimPrefix(height, "0x"), 16, 64)
+}
+
+// WaitFor polls the published cluster info and the node APIs until all
+// conditions hold, sending the statuses whenever they change.
+func (s *server) WaitFor(req *rpcpb.WaitForRequest, stream rpcpb.ControlService_WaitForServer) error {
+	zap.L().Info("received wait for request", zap.Int("conditions", len(req.GetConditions())))
+	c, err := s.getCluster(req.GetClusterName())
+	if err != nil {
+		return err
+	}
+	if err := checkWaitConditions(req.GetConditions()); err != nil {
+		return err
+	}
+	timeout := time.Duration(req.GetTimeout())
+	if timeout == 0 {
+		timeout = defaultWaitTimeout
+	}
+	interval := time.Duration(req.GetPollInterval())
+	if interval == 0 {
+		interval = defaultWaitPollInterval
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), timeout)
+	defer cancel()
+	var last *rpcpb.WaitForResponse
+	done := func() error {
+		if stream.Context().Err() != nil {
+			// client is done waiting
+			return nil
+		}
+		var pending []string
+		for _, status := range last.GetStatuses() {
+			if !status.Satisfied {
+				pending = append(pending, status.Message)
+			}
+		}
+		return fmt.Errorf("conditions not satisfied in %v (%s): %w", timeout, strings.Join(pending, "; "), ctx.Err())
+	}
+	for {
+		// the node requests of a round may take up to their own timeout,
+		// so do not wait for the round once [ctx] is done
+		ci := c.getInfo()
+		respc := make(chan *rpcpb.WaitForResponse, 1)
+		go func() {
+			respc <- checkWaitRound(ci, req.GetConditions())
+		}()
+		var resp *rpcpb.WaitForResponse
+		select {
+		case resp = <-respc:
+		case <-s.closed:
+			return ErrClosed
+		case <-ctx.Done():
+			return done()
+		}
+		if !proto.Equal(resp, last) {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			last = resp
+		}
+		if resp.Satisfied {
+			return nil
+		}
+
+		select {
+		case <-s.closed:
+			return ErrClosed
+		case <-ctx.Done():
+			return done()
+		case <-time.After(interval):
+		}
+	}
+}
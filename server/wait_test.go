@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gyuho/avax-tester/rpcpb"
+	"google.golang.org/grpc"
+)
+
+func TestCheckWaitConditions(t *testing.T) {
+	tests := []struct {
+		conds []*rpcpb.WaitCondition
+		valid bool
+	}{
+		{conds: nil, valid: false},
+		{conds: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_HEALTHY}}, valid: true},
+		{conds: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_NODE_COUNT}}, valid: false},
+		{conds: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_NODE_COUNT, NodeCount: 5}}, valid: true},
+		{conds: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED}}, valid: false},
+		{conds: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED, Chain: "X"}}, valid: true},
+		{conds: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_VALIDATOR}}, valid: false},
+		{conds: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_VALIDATOR, NodeId: "node1", SubnetId: "invalid"}}, valid: false},
+		{conds: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_VALIDATOR, NodeId: "node1"}}, valid: true},
+		{conds: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_HEALTHY}, {}}, valid: false},
+	}
+	for i, tv := range tests {
+		err := checkWaitConditions(tv.conds)
+		if tv.valid && err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		if !tv.valid && !errors.Is(err, ErrInvalidWaitCondition) {
+			t.Fatalf("#%d: expected %v, got %v", i, ErrInvalidWaitCondition, err)
+		}
+	}
+}
+
+func TestCheckWaitCondition(t *testing.T) {
+	healthy := &rpcpb.WaitCondition{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_HEALTHY}
+	nodeCount := &rpcpb.WaitCondition{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_NODE_COUNT, NodeCount: 2}
+	if ok, _ := checkWaitCondition(nil, healthy); ok {
+		t.Fatal("condition holds without a cluster")
+	}
+
+	ci := &rpcpb.ClusterInfo{
+		NodeNames: []string{"node1", "node2"},
+		NodeInfos: map[string]*rpcpb.NodeInfo{
+			"node1": {Uri: "http://127.0.0.1:9650", Healthy: true},
+			// not started yet
+			"node2": {Healthy: true},
+		},
+	}
+	if ok, msg := checkWaitCondition(ci, healthy); ok || msg != "1/2 nodes healthy" {
+		t.Fatalf("unexpected status %v, %q", ok, msg)
+	}
+	ci.NodeInfos["node2"].Uri = "http://127.0.0.1:9652"
+	if ok, msg := checkWaitCondition(ci, healthy); !ok || msg != "2/2 nodes healthy" {
+		t.Fatalf("unexpected status %v, %q", ok, msg)
+	}
+	if ok, _ := checkWaitCondition(ci, nodeCount); !ok {
+		t.Fatal("node count not reached")
+	}
+	nodeCount.NodeCount = 3
+	if ok, _ := checkWaitCondition(ci, nodeCount); ok {
+		t.Fatal("node count reached")
+	}
+}
+
+func TestCountNodesConcurrent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	ci := &rpcpb.ClusterInfo{NodeInfos: make(map[string]*rpcpb.NodeInfo)}
+	for _, name := range []string{"node1", "node2", "node3", "node4", "node5", "node6"} {
+		ci.NodeNames = append(ci.NodeNames, name)
+		ci.NodeInfos[name] = &rpcpb.NodeInfo{Uri: srv.URL, Healthy: true}
+	}
+	cond := &rpcpb.WaitCondition{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED, Chain: "X"}
+
+	start := time.Now()
+	if ok, _ := checkWaitCondition(ci, cond); ok {
+		t.Fatal("condition holds on invalid responses")
+	}
+	// the nodes are queried concurrently
+	if took := time.Since(start); took > time.Second {
+		t.Fatalf("check took %v", took)
+	}
+}
+
+// testWaitStream collects the statuses sent to a WaitFor client.
+type testWaitStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	resps []*rpcpb.WaitForResponse
+}
+
+func (s *testWaitStream) Context() context.Context { return s.ctx }
+
+func (s *testWaitStream) Send(resp *rpcpb.WaitForResponse) error {
+	s.resps = append(s.resps, resp)
+	return nil
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	// nodes that never respond
+	donec := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-donec
+	}))
+	defer srv.Close()
+	defer close(donec)
+
+	s := newTestServer(t)
+	c := newCluster(DefaultClusterName)
+	c.info = &rpcpb.ClusterInfo{
+		NodeNames: []string{"node1"},
+		NodeInfos: map[string]*rpcpb.NodeInfo{"node1": {Uri: srv.URL, Healthy: true}},
+	}
+	s.clusters[DefaultClusterName] = c
+
+	stream := &testWaitStream{ctx: context.Background()}
+	start := time.Now()
+	err := s.WaitFor(&rpcpb.WaitForRequest{
+		Conditions: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED, Chain: "X"}},
+		Timeout:    int64(300 * time.Millisecond),
+	}, stream)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	// the wait is not held up by the node requests
+	if took := time.Since(start); took >= pChainRequestTimeout {
+		t.Fatalf("wait took %v", took)
+	}
+
+	// the client is done waiting
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := s.WaitFor(&rpcpb.WaitForRequest{
+		Conditions: []*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED, Chain: "X"}},
+	}, &testWaitStream{ctx: ctx}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -7,12 +7,14 @@ package e2e_test
 import (
 	"context"
 	"flag"
+	"syscall"
 	"testing"
 	"time"
 
 	"github.com/gyuho/avax-tester/client"
 	"github.com/gyuho/avax-tester/pkg/color"
 	"github.com/gyuho/avax-tester/pkg/logutil"
+	"github.com/gyuho/avax-tester/rpcpb"
 	ginkgo "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 )
@@ -90,8 +92,11 @@ var _ = ginkgo.AfterSuite(func() {
 var _ = ginkgo.Describe("[Start/Remove/Restart/Stop]", func() {
 	ginkgo.It("can start", func() {
 		ginkgo.By("calling start API with the first binary", func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-			resp, err := cli.Start(ctx, execPath1)
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+			resp, err := cli.Start(ctx, execPath1,
+				client.WithWaitForHealthy(true),
+				client.WithRestartPolicy(&rpcpb.RestartPolicy{Type: rpcpb.RestartPolicyType_RESTART_POLICY_TYPE_ON_FAILURE}),
+			)
 			cancel()
 			gomega.Ω(err).Should(gomega.BeNil())
 			color.Outf("{{green}}successfully started:{{/}} %+v\n", resp.ClusterInfo.NodeNames)
@@ -99,9 +104,6 @@ var _ = ginkgo.Describe("[Start/Remove/Restart/Stop]", func() {
 	})
 
 	ginkgo.It("can wait for health", func() {
-		// start is async, so wait some time for cluster health
-		time.Sleep(2 * time.Minute)
-
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 		_, err := cli.Health(ctx)
 		cancel()
@@ -123,6 +125,40 @@ var _ = ginkgo.Describe("[Start/Remove/Restart/Stop]", func() {
 		gomega.Ω(err).Should(gomega.BeNil())
 	})
 
+	ginkgo.It("can fetch node process and health states", func() {
+		// refreshed in the background
+		gomega.Eventually(func() bool {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			resp, err := cli.Status(ctx)
+			cancel()
+			gomega.Ω(err).Should(gomega.BeNil())
+			for _, info := range resp.ClusterInfo.NodeInfos {
+				if info.State != rpcpb.NodeState_NODE_STATE_RUNNING || info.Pid == 0 || !info.Healthy || info.LastChecked == 0 {
+					return false
+				}
+			}
+			return true
+		}, time.Minute, 5*time.Second).Should(gomega.BeTrue())
+	})
+
+	ginkgo.It("can restart exited node", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		resp, err := cli.Status(ctx)
+		cancel()
+		gomega.Ω(err).Should(gomega.BeNil())
+		pid := resp.ClusterInfo.NodeInfos["node1"].Pid
+		gomega.Ω(syscall.Kill(int(pid), syscall.SIGKILL)).Should(gomega.BeNil())
+
+		gomega.Eventually(func() bool {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			resp, err := cli.Status(ctx)
+			cancel()
+			gomega.Ω(err).Should(gomega.BeNil())
+			info := resp.ClusterInfo.NodeInfos["node1"]
+			return info.CrashCount == 1 && info.Pid != pid && info.Healthy
+		}, 3*time.Minute, 5*time.Second).Should(gomega.BeTrue())
+	})
+
 	ginkgo.It("can poll status", func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 		defer cancel()
@@ -136,25 +172,169 @@ var _ = ginkgo.Describe("[Start/Remove/Restart/Stop]", func() {
 		}
 	})
 
+	ginkgo.It("can get node metrics", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		resp, err := cli.GetNodeMetrics(ctx, client.WithMetricNames("avalanche_P_blks_accepted_count"))
+		cancel()
+		gomega.Ω(err).Should(gomega.BeNil())
+		gomega.Ω(resp.NodeMetrics).Should(gomega.HaveLen(5))
+		for name, nm := range resp.NodeMetrics {
+			gomega.Ω(nm.Error).Should(gomega.BeEmpty(), name)
+			gomega.Ω(nm.MetricFamilies).Should(gomega.HaveLen(1), name)
+			gomega.Ω(nm.MetricFamilies[0].Name).Should(gomega.Equal("avalanche_P_blks_accepted_count"))
+		}
+	})
+
+	ginkgo.It("can stream logs", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		ch, err := cli.StreamLogs(ctx, client.WithNodeNames("node1"), client.WithLogStream("main.log"), client.WithTail(10))
+		gomega.Ω(err).Should(gomega.BeNil())
+		lines := 0
+		for resp := range ch {
+			gomega.Ω(resp.NodeName).Should(gomega.Equal("node1"))
+			lines++
+		}
+		gomega.Ω(lines).Should(gomega.BeNumerically(">", 0))
+		gomega.Ω(lines).Should(gomega.BeNumerically("<=", 10))
+	})
+
+	ginkgo.It("can wait for chains", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+		resp, err := cli.WaitFor(ctx, []*rpcpb.WaitCondition{
+			{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED, Chain: "X"},
+			{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED, Chain: "P"},
+			{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_BOOTSTRAPPED, Chain: "C"},
+			{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_VALIDATOR, NodeId: "node1"},
+		}, client.WithWaitTimeout(2*time.Minute))
+		cancel()
+		gomega.Ω(err).Should(gomega.BeNil())
+		gomega.Ω(resp.Satisfied).Should(gomega.BeTrue())
+	})
+
 	ginkgo.It("can remove", func() {
-		time.Sleep(time.Minute)
+		waitForHealthy()
 		ginkgo.By("calling remove API with the first binary", func() {
 			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 			resp, err := cli.RemoveNode(ctx, "node5")
+			gomega.Ω(err).Should(gomega.BeNil())
+			op, err := cli.WaitOperation(ctx, resp.Operation.Id)
+			cancel()
+			gomega.Ω(err).Should(gomega.BeNil())
+			gomega.Ω(op.State).Should(gomega.Equal(rpcpb.OperationState_OPERATION_STATE_SUCCEEDED))
+			color.Outf("{{green}}successfully removed:{{/}} %+v\n", op.ClusterInfo.NodeNames)
+		})
+		ginkgo.By("waiting for the remaining nodes", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+			_, err := cli.WaitFor(ctx, []*rpcpb.WaitCondition{
+				{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_NODE_COUNT, NodeCount: 4},
+			}, client.WithWaitTimeout(2*time.Minute))
 			cancel()
 			gomega.Ω(err).Should(gomega.BeNil())
-			color.Outf("{{green}}successfully removed:{{/}} %+v\n", resp.ClusterInfo.NodeNames)
 		})
 	})
 
 	ginkgo.It("can restart", func() {
-		time.Sleep(time.Minute)
+		waitForHealthy()
 		ginkgo.By("calling restart API with the second binary", func() {
 			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 			resp, err := cli.RestartNode(ctx, "node4", execPath2)
+			gomega.Ω(err).Should(gomega.BeNil())
+			op, err := cli.WaitOperation(ctx, resp.Operation.Id)
 			cancel()
 			gomega.Ω(err).Should(gomega.BeNil())
-			color.Outf("{{green}}successfully restarted:{{/}} %+v\n", resp.ClusterInfo.NodeNames)
+			gomega.Ω(op.State).Should(gomega.Equal(rpcpb.OperationState_OPERATION_STATE_SUCCEEDED))
+			color.Outf("{{green}}successfully restarted:{{/}} %+v\n", op.ClusterInfo.NodeNames)
 		})
 	})
+
+	ginkgo.It("can watch events", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		// from the first event, to see the restart above
+		ch, err := cli.WatchEvents(ctx, client.WithStartRevision(1))
+		gomega.Ω(err).Should(gomega.BeNil())
+		var rev uint64
+		restarting, restarted := false, false
+		for ev := range ch {
+			gomega.Ω(ev.Revision).Should(gomega.BeNumerically(">", rev))
+			rev = ev.Revision
+			if ev.NodeName != "node4" {
+				continue
+			}
+			switch ev.Type {
+			case rpcpb.EventType_EVENT_TYPE_NODE_RESTARTING:
+				restarting = true
+			case rpcpb.EventType_EVENT_TYPE_NODE_RESTARTED:
+				restarted = true
+			}
+			if restarting && restarted {
+				break
+			}
+		}
+		gomega.Ω(restarting).Should(gomega.BeTrue())
+		gomega.Ω(restarted).Should(gomega.BeTrue())
+	})
+
+	ginkgo.It("can rolling upgrade", func() {
+		ginkgo.By("calling rolling upgrade API with the second binary", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+			resp, err := cli.RollingUpgrade(ctx, execPath2)
+			gomega.Ω(err).Should(gomega.BeNil())
+			op, err := cli.WaitOperation(ctx, resp.Operation.Id)
+			cancel()
+			gomega.Ω(err).Should(gomega.BeNil())
+			gomega.Ω(op.State).Should(gomega.Equal(rpcpb.OperationState_OPERATION_STATE_SUCCEEDED))
+			for _, nu := range op.RollingUpgradeReport.Nodes {
+				if nu.Name == "node4" {
+					// already upgraded by restart
+					gomega.Ω(nu.State).Should(gomega.Equal(rpcpb.UpgradeState_UPGRADE_STATE_SKIPPED))
+					continue
+				}
+				gomega.Ω(nu.State).Should(gomega.Equal(rpcpb.UpgradeState_UPGRADE_STATE_UPGRADED))
+			}
+			for _, info := range op.ClusterInfo.NodeInfos {
+				gomega.Ω(info.ExecPath).Should(gomega.Equal(execPath2))
+			}
+			color.Outf("{{green}}successfully upgraded:{{/}} %+v\n", op.ClusterInfo.NodeNames)
+		})
+	})
+
+	ginkgo.It("can create subnet", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		resp, err := cli.CreateSubnet(ctx, client.WithNodeNames("node1", "node2"))
+		gomega.Ω(err).Should(gomega.BeNil())
+		op, err := cli.WaitOperation(ctx, resp.Operation.Id)
+		gomega.Ω(err).Should(gomega.BeNil())
+		gomega.Ω(op.State).Should(gomega.Equal(rpcpb.OperationState_OPERATION_STATE_SUCCEEDED))
+		gomega.Ω(op.ClusterInfo.SubnetIds).Should(gomega.ContainElement(op.SubnetId))
+
+		subnets, err := cli.ListSubnets(ctx)
+		cancel()
+		gomega.Ω(err).Should(gomega.BeNil())
+		found := false
+		for _, subnet := range subnets {
+			if subnet.Id == op.SubnetId {
+				found = true
+				gomega.Ω(subnet.ValidatorNodeNames).Should(gomega.ConsistOf("node1", "node2"))
+			}
+		}
+		gomega.Ω(found).Should(gomega.BeTrue())
+		color.Outf("{{green}}successfully created subnet:{{/}} %s\n", op.SubnetId)
+	})
 })
+
+// waitForHealthy blocks until all nodes are healthy, printing the progress.
+func waitForHealthy() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+	_, err := cli.WaitFor(
+		ctx,
+		[]*rpcpb.WaitCondition{{Type: rpcpb.WaitConditionType_WAIT_CONDITION_TYPE_HEALTHY}},
+		client.WithWaitTimeout(2*time.Minute),
+		client.WithProgress(func(resp *rpcpb.WaitForResponse) {
+			color.Outf("{{blue}}waiting:{{/}} %s\n", resp.Statuses[0].Message)
+		}),
+	)
+	gomega.Ω(err).Should(gomega.BeNil())
+}